@@ -0,0 +1,117 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/strategies"
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+func getMostConstrainedCell(grid *sudoku.Grid) *sudoku.Cell {
+	var most_constrained_cell *sudoku.Cell = nil
+	most_constrained_count := 0
+
+	for _, cell := range grid.GetAllCells() {
+		if cell.GetValue() != sudoku.Empty {
+			continue
+		}
+
+		pencil_mark_count := len(cell.GetPencilMarks())
+		if most_constrained_cell == nil || pencil_mark_count < most_constrained_count {
+			most_constrained_cell = cell
+			most_constrained_count = pencil_mark_count
+		}
+
+		if most_constrained_count <= 1 {
+			break
+		}
+	}
+
+	return most_constrained_cell
+}
+
+func placeDigit(grid *sudoku.Grid, row int, column int, digit int) {
+	cell, err := grid.GetCell(row, column)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if err := cell.SetValue(digit); err != nil {
+		panic(err.Error())
+	}
+
+	cells_in_row, _ := grid.GetCellsInRow(row)
+	cells_in_column, _ := grid.GetCellsInColumn(column)
+	cells_in_box, _ := grid.GetCellsInBox(cell.GetBoxId())
+
+	for _, cells := range [][9]*sudoku.Cell{cells_in_row, cells_in_column, cells_in_box} {
+		for _, other_cell := range cells {
+			if other_cell.GetValue() == sudoku.Empty {
+				if err := other_cell.RemovePencilMark(digit); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+	}
+}
+
+// searchSolutions calls found for every solution of the grid until it returns
+// false. The grid's pencil marks restrict the digits tried in each cell, and
+// the grid itself is used as scratch space.
+func searchSolutions(grid *sudoku.Grid, found func(solution *sudoku.Grid) bool) bool {
+	cell := getMostConstrainedCell(grid)
+	if cell == nil {
+		return found(grid)
+	}
+
+	pencil_marks := cell.GetPencilMarks()
+
+	// A forced digit has no alternative to backtrack to, so it can be placed in place.
+	if len(pencil_marks) == 1 {
+		placeDigit(grid, cell.GetRowId(), cell.GetColumnId(), pencil_marks[0])
+		return searchSolutions(grid, found)
+	}
+
+	for _, digit := range pencil_marks {
+		candidate_grid := grid.Clone()
+		placeDigit(candidate_grid, cell.GetRowId(), cell.GetColumnId(), digit)
+
+		if !searchSolutions(candidate_grid, found) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func prepareSearch(grid *sudoku.Grid) (*sudoku.Grid, error) {
+	if err := grid.Validate(); err != nil {
+		return nil, err
+	}
+
+	search_grid := grid.Clone()
+	if _, err := strategies.SeenCells(search_grid); err != nil {
+		return nil, err
+	}
+
+	return search_grid, nil
+}
+
+func BruteForce(grid *sudoku.Grid) (*sudoku.Grid, error) {
+	search_grid, err := prepareSearch(grid)
+	if err != nil {
+		return nil, err
+	}
+
+	var solution *sudoku.Grid = nil
+	searchSolutions(search_grid, func(found_solution *sudoku.Grid) bool {
+		solution = found_solution
+		return false
+	})
+
+	if solution == nil {
+		return nil, fmt.Errorf("grid has no solution")
+	}
+
+	return solution, nil
+}
@@ -0,0 +1,110 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+var hard_puzzles = []string{
+	"4.....8.5.3..........7......2.....6.....8.4......1.......6.3.7.5..2.....1.4......",
+	"52...6.........7.13...........4..8..6......5...........418.........3..2...87.....",
+	"6.....8.3.4.7.................5.4.7.3..2.....1.6.......2.....5.....8.6......1....",
+	"48.3............71.2.......7.5....6....2..8.............1.76...3.....4......5....",
+	"8..........36......7..9.2...5...7.......457.....1...3...1....68..85...1..9....4..",
+}
+
+func TestBruteForce(t *testing.T) {
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadLineString("003020600900305001001806400008102900700000008006708200002609500800203009005010300"))
+	initial_line_string := grid.LineString()
+
+	solution, err := BruteForce(grid)
+	AssertNoError(t, err)
+
+	if solution.LineString() != "483921657967345821251876493548132976729564138136798245372689514814253769695417382" {
+		t.Errorf("unexpected solution: %s", solution.LineString())
+	}
+
+	if grid.LineString() != initial_line_string {
+		t.Errorf("input grid should not be modified: %s", grid.LineString())
+	}
+}
+
+func TestBruteForceHardPuzzles(t *testing.T) {
+	for _, puzzle := range hard_puzzles {
+		grid := sudoku.NewGrid()
+		AssertNoError(t, grid.LoadLineString(puzzle))
+
+		solution, err := BruteForce(grid)
+		AssertNoError(t, err)
+
+		if !solution.IsSolved() {
+			t.Errorf("grid is not solved: %s", solution.LineString())
+		}
+
+		for i, cell := range grid.GetAllCells() {
+			if cell.GetValue() != sudoku.Empty && cell.GetValue() != solution.GetAllCells()[i].GetValue() {
+				t.Errorf("solution does not keep the given digit of %s: %s", cell, solution.LineString())
+			}
+		}
+	}
+}
+
+func TestBruteForceNoSolution(t *testing.T) {
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadLineString("12345678.........9..............................................................."))
+
+	_, err := BruteForce(grid)
+	AssertError(t, err)
+
+	AssertNoError(t, grid.LoadLineString("11..............................................................................."))
+
+	_, err = BruteForce(grid)
+	AssertError(t, err)
+}
+
+func TestBruteForceUsesPencilMarks(t *testing.T) {
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadLineString("003020600900305001001806400008102900700000008006708200002609500800203009005010300"))
+
+	cell, _ := grid.GetCell(1, 1)
+	cell.RemovePencilMark(4)
+
+	_, err := BruteForce(grid)
+	AssertError(t, err)
+}
+
+func TestStrategiesAgreeWithBruteForce(t *testing.T) {
+	strategy_list := DefaultStrategies()
+
+	for _, puzzle := range hard_puzzles {
+		grid := sudoku.NewGrid()
+		AssertNoError(t, grid.LoadLineString(puzzle))
+
+		solution, err := BruteForce(grid)
+		AssertNoError(t, err)
+		solution_cells := solution.GetAllCells()
+
+		for !grid.IsSolved() {
+			step, err := applyFirstStrategy(grid, strategy_list)
+			AssertNoError(t, err)
+			if step == nil {
+				break
+			}
+
+			for _, placement := range step.Placements {
+				if solution_cells[(placement.Cell.GetRowId()-1)*9+placement.Cell.GetColumnId()-1].GetValue() != placement.Digit {
+					t.Errorf("wrong placement in %s: %s", puzzle, step)
+				}
+			}
+
+			for _, elimination := range step.Eliminations {
+				if solution_cells[(elimination.Cell.GetRowId()-1)*9+elimination.Cell.GetColumnId()-1].GetValue() == elimination.Digit {
+					t.Errorf("wrong elimination in %s: %s", puzzle, step)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+type Uniqueness int
+
+const (
+	NoSolution Uniqueness = iota
+	UniqueSolution
+	MultipleSolutions
+)
+
+func (u Uniqueness) String() string {
+	switch u {
+	case NoSolution:
+		return "no solution"
+	case UniqueSolution:
+		return "unique solution"
+	case MultipleSolutions:
+		return "multiple solutions"
+	default:
+		return "unknown"
+	}
+}
+
+type SolutionCount struct {
+	Count      int
+	Uniqueness Uniqueness
+	Solutions  []*sudoku.Grid
+}
+
+func CountSolutions(grid *sudoku.Grid, limit int) (*SolutionCount, error) {
+	if limit < 2 {
+		return nil, fmt.Errorf("limit must be at least 2 to decide uniqueness: %d", limit)
+	}
+
+	search_grid, err := prepareSearch(grid)
+	if err != nil {
+		return nil, err
+	}
+
+	solution_count := &SolutionCount{Count: 0, Uniqueness: NoSolution, Solutions: []*sudoku.Grid{}}
+
+	searchSolutions(search_grid, func(solution *sudoku.Grid) bool {
+		solution_count.Solutions = append(solution_count.Solutions, solution)
+		return len(solution_count.Solutions) < limit
+	})
+
+	solution_count.Count = len(solution_count.Solutions)
+
+	switch solution_count.Count {
+	case 0:
+		solution_count.Uniqueness = NoSolution
+	case 1:
+		solution_count.Uniqueness = UniqueSolution
+	default:
+		solution_count.Uniqueness = MultipleSolutions
+	}
+
+	return solution_count, nil
+}
+
+// GetDifferingCells returns the cells of the first solution whose value differs
+// in the second one, showing where an ambiguous puzzle is broken.
+func (s *SolutionCount) GetDifferingCells() []*sudoku.Cell {
+	differing_cells := []*sudoku.Cell{}
+
+	if len(s.Solutions) < 2 {
+		return differing_cells
+	}
+
+	other_cells := s.Solutions[1].GetAllCells()
+	for i, cell := range s.Solutions[0].GetAllCells() {
+		if cell.GetValue() != other_cells[i].GetValue() {
+			differing_cells = append(differing_cells, cell)
+		}
+	}
+
+	return differing_cells
+}
@@ -0,0 +1,80 @@
+package solver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func assertUniqueness(t *testing.T, actual *SolutionCount, expected Uniqueness, expected_count int) {
+	if actual.Uniqueness != expected {
+		t.Errorf("unexpected uniqueness. expected: %s, actual: %s", expected, actual.Uniqueness)
+	}
+
+	if actual.Count != expected_count || len(actual.Solutions) != expected_count {
+		t.Errorf("unexpected number of solutions. expected: %d, actual: %d", expected_count, actual.Count)
+	}
+}
+
+func TestCountSolutionsUnique(t *testing.T) {
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadLineString(hard_puzzles[0]))
+
+	solution_count, err := CountSolutions(grid, 2)
+	AssertNoError(t, err)
+	assertUniqueness(t, solution_count, UniqueSolution, 1)
+
+	if !solution_count.Solutions[0].IsSolved() {
+		t.Errorf("grid is not solved: %s", solution_count.Solutions[0].LineString())
+	}
+
+	if len(solution_count.GetDifferingCells()) != 0 {
+		t.Errorf("a unique solution should not have differing cells")
+	}
+}
+
+func TestCountSolutionsMultiple(t *testing.T) {
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadLineString("4.3921.579.7345.21251876493548132976729564138136798245372689514814253769695417382"))
+
+	solution_count, err := CountSolutions(grid, 2)
+	AssertNoError(t, err)
+	assertUniqueness(t, solution_count, MultipleSolutions, 2)
+
+	differing_cells := solution_count.GetDifferingCells()
+	if fmt.Sprint(differing_cells) != "[r1c2 r1c7 r2c2 r2c7]" {
+		t.Errorf("unexpected differing cells: %s", differing_cells)
+	}
+}
+
+func TestCountSolutionsLimit(t *testing.T) {
+	solution_count, err := CountSolutions(sudoku.NewGrid(), 5)
+	AssertNoError(t, err)
+	assertUniqueness(t, solution_count, MultipleSolutions, 5)
+
+	_, err = CountSolutions(sudoku.NewGrid(), 1)
+	AssertError(t, err)
+}
+
+func TestCountSolutionsNone(t *testing.T) {
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadLineString("12345678.........9..............................................................."))
+
+	solution_count, err := CountSolutions(grid, 2)
+	AssertNoError(t, err)
+	assertUniqueness(t, solution_count, NoSolution, 0)
+}
+
+func TestCountSolutionsInvalid(t *testing.T) {
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadLineString("11..............................................................................."))
+
+	solution_count, err := CountSolutions(grid, 2)
+	AssertError(t, err)
+
+	if solution_count != nil {
+		t.Errorf("unexpected solution count for an invalid grid: %s", solution_count.Uniqueness)
+	}
+}
@@ -0,0 +1,94 @@
+package solver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alltilla/sudoku-solver/internal/strategies"
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+type Status int
+
+const (
+	Stuck Status = iota
+	Solved
+	Contradiction
+)
+
+func (s Status) String() string {
+	switch s {
+	case Stuck:
+		return "stuck"
+	case Solved:
+		return "solved"
+	case Contradiction:
+		return "contradiction"
+	default:
+		return "unknown"
+	}
+}
+
+type Result struct {
+	Status Status
+	Error  error
+	Steps  []*strategies.Step
+}
+
+func (r *Result) Walkthrough() string {
+	lines := []string{}
+	for i, step := range r.Steps {
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, step))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func DefaultStrategies() []strategies.Strategy {
+	return strategies.NewDefaultRegistry().GetStrategies()
+}
+
+func applyFirstStrategy(grid *sudoku.Grid, strategy_list []strategies.Strategy) (*strategies.Step, error) {
+	for _, strategy := range strategy_list {
+		step, err := strategy.Apply(grid)
+		if err != nil || step != nil {
+			return step, err
+		}
+	}
+
+	return nil, nil
+}
+
+func Solve(grid *sudoku.Grid, strategy_list []strategies.Strategy) *Result {
+	result := &Result{Status: Stuck, Steps: []*strategies.Step{}}
+
+	if err := grid.Validate(); err != nil {
+		result.Status = Contradiction
+		result.Error = err
+		return result
+	}
+
+	for !grid.IsSolved() {
+		step, err := applyFirstStrategy(grid, strategy_list)
+		if err != nil {
+			result.Status = Contradiction
+			result.Error = err
+			return result
+		}
+
+		if step == nil {
+			return result
+		}
+
+		result.Steps = append(result.Steps, step)
+
+		if err := grid.Validate(); err != nil {
+			result.Status = Contradiction
+			result.Error = err
+			return result
+		}
+	}
+
+	result.Status = Solved
+	return result
+}
@@ -0,0 +1,254 @@
+package solver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/strategies"
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func assertStatus(t *testing.T, actual Status, expected Status) {
+	if actual != expected {
+		t.Errorf("unexpected status. expected: %s, actual: %s", expected, actual)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 |       || 1 2 3 |       | 1 2 3 ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (3)  || 4 5 6 |  (2)  | 4 5 6 ||  (6)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       || 7 8 9 |       | 7 8 9 ||       | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2 3 | 1 2 3 ||       | 1 2 3 |       || 1 2 3 | 1 2 3 |       ||
+||  (9)  | 4 5 6 | 4 5 6 ||  (3)  | 4 5 6 |  (5)  || 4 5 6 | 4 5 6 |  (1)  ||
+||       | 7 8 9 | 7 8 9 ||       | 7 8 9 |       || 7 8 9 | 7 8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 |       ||       | 1 2 3 |       ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (1)  ||  (8)  | 4 5 6 |  (6)  ||  (4)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       ||       | 7 8 9 |       ||       | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 |       ||       | 1 2 3 |       ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (8)  ||  (1)  | 4 5 6 |  (2)  ||  (9)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       ||       | 7 8 9 |       ||       | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 |       ||
+||  (7)  | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 |  (8)  ||
+||       | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 |       ||       | 1 2 3 |       ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (6)  ||  (7)  | 4 5 6 |  (8)  ||  (2)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       ||       | 7 8 9 |       ||       | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 |       ||       | 1 2 3 |       ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (2)  ||  (6)  | 4 5 6 |  (9)  ||  (5)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       ||       | 7 8 9 |       ||       | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2 3 | 1 2 3 ||       | 1 2 3 |       || 1 2 3 | 1 2 3 |       ||
+||  (8)  | 4 5 6 | 4 5 6 ||  (2)  | 4 5 6 |  (3)  || 4 5 6 | 4 5 6 |  (9)  ||
+||       | 7 8 9 | 7 8 9 ||       | 7 8 9 |       || 7 8 9 | 7 8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 |       || 1 2 3 |       | 1 2 3 ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (5)  || 4 5 6 |  (1)  | 4 5 6 ||  (3)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       || 7 8 9 |       | 7 8 9 ||       | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (4)  |  (8)  |  (3)  ||  (9)  |  (2)  |  (1)  ||  (6)  |  (5)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (6)  |  (7)  ||  (3)  |  (4)  |  (5)  ||  (8)  |  (2)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (1)  ||  (8)  |  (7)  |  (6)  ||  (4)  |  (9)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (4)  |  (8)  ||  (1)  |  (3)  |  (2)  ||  (9)  |  (7)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (2)  |  (9)  ||  (5)  |  (6)  |  (4)  ||  (1)  |  (3)  |  (8)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (3)  |  (6)  ||  (7)  |  (9)  |  (8)  ||  (2)  |  (4)  |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |  (7)  |  (2)  ||  (6)  |  (8)  |  (9)  ||  (5)  |  (1)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (1)  |  (4)  ||  (2)  |  (5)  |  (3)  ||  (7)  |  (6)  |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (6)  |  (9)  |  (5)  ||  (4)  |  (1)  |  (7)  ||  (3)  |  (8)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	result := Solve(grid, DefaultStrategies())
+	AssertNoError(t, result.Error)
+	assertStatus(t, result.Status, Solved)
+
+	expected_grid := sudoku.NewGrid()
+	AssertNoError(t, expected_grid.LoadPrettyString(expected_grid_str))
+
+	if !grid.Equals(expected_grid) {
+		t.Errorf("unexpected grid")
+	}
+}
+
+func TestSolveStuck(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||       | 1 2 3 |       ||
+||  (4)  | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||  (8)  | 4 5 6 |  (5)  ||
+||       | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||       | 7 8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 |       | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 |  (3)  | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 |       | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 ||       | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 ||  (7)  | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 ||       | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+|| 1 2 3 |       | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 |       | 1 2 3 ||
+|| 4 5 6 |  (2)  | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 |  (6)  | 4 5 6 ||
+|| 7 8 9 |       | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 |       | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 |       | 1 2 3 ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 |  (8)  | 4 5 6 ||  (4)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 |       | 7 8 9 ||       | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 |       | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 |  (1)  | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 |       | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 | 1 2 3 ||       | 1 2 3 |       || 1 2 3 |       | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 ||  (6)  | 4 5 6 |  (3)  || 4 5 6 |  (7)  | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 ||       | 7 8 9 |       || 7 8 9 |       | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2 3 | 1 2 3 ||       | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+||  (5)  | 4 5 6 | 4 5 6 ||  (2)  | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+||       | 7 8 9 | 7 8 9 ||       | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2 3 |       || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+||  (1)  | 4 5 6 |  (4)  || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+||       | 7 8 9 |       || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	singles, err := strategies.NewDefaultRegistry().GetStrategiesByName([]string{"Seen Cells", "Naked Single", "Hidden Single"})
+	AssertNoError(t, err)
+
+	result := Solve(grid, singles)
+	AssertNoError(t, result.Error)
+	assertStatus(t, result.Status, Stuck)
+
+	if len(result.Steps) == 0 {
+		t.Errorf("missing change")
+	}
+
+	walkthrough := strings.Split(result.Walkthrough(), "\n")
+	if len(walkthrough) != len(result.Steps) {
+		t.Errorf("unexpected number of walkthrough lines: %d", len(walkthrough))
+	}
+
+	if walkthrough[1] != "2. Hidden Single: r6c2 is the only place for 4 in column 2 => r6c2=4" {
+		t.Errorf("unexpected walkthrough line: %s", walkthrough[1])
+	}
+}
+
+func TestSolveContradiction(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1 2 3 |       |       || 1 2 3 |       | 1 2 3 ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 |  (3)  |  (3)  || 4 5 6 |  (2)  | 4 5 6 ||  (6)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 |       |       || 7 8 9 |       | 7 8 9 ||       | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2 3 | 1 2 3 ||       | 1 2 3 |       || 1 2 3 | 1 2 3 |       ||
+||  (9)  | 4 5 6 | 4 5 6 ||  (3)  | 4 5 6 |  (5)  || 4 5 6 | 4 5 6 |  (1)  ||
+||       | 7 8 9 | 7 8 9 ||       | 7 8 9 |       || 7 8 9 | 7 8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 |       ||       | 1 2 3 |       ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (1)  ||  (8)  | 4 5 6 |  (6)  ||  (4)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       ||       | 7 8 9 |       ||       | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 |       ||       | 1 2 3 |       ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (8)  ||  (1)  | 4 5 6 |  (2)  ||  (9)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       ||       | 7 8 9 |       ||       | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 |       ||
+||  (7)  | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 |  (8)  ||
+||       | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 |       ||       | 1 2 3 |       ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (6)  ||  (7)  | 4 5 6 |  (8)  ||  (2)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       ||       | 7 8 9 |       ||       | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 |       ||       | 1 2 3 |       ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (2)  ||  (6)  | 4 5 6 |  (9)  ||  (5)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       ||       | 7 8 9 |       ||       | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2 3 | 1 2 3 ||       | 1 2 3 |       || 1 2 3 | 1 2 3 |       ||
+||  (8)  | 4 5 6 | 4 5 6 ||  (2)  | 4 5 6 |  (3)  || 4 5 6 | 4 5 6 |  (9)  ||
+||       | 7 8 9 | 7 8 9 ||       | 7 8 9 |       || 7 8 9 | 7 8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 |       || 1 2 3 |       | 1 2 3 ||       | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 |  (5)  || 4 5 6 |  (1)  | 4 5 6 ||  (3)  | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 |       || 7 8 9 |       | 7 8 9 ||       | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	result := Solve(grid, DefaultStrategies())
+	AssertError(t, result.Error)
+	assertStatus(t, result.Status, Contradiction)
+}
+
+func TestSolveLineString(t *testing.T) {
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadLineString("003020600900305001001806400008102900700000008006708200002609500800203009005010300"))
+
+	result := Solve(grid, DefaultStrategies())
+	AssertNoError(t, result.Error)
+	assertStatus(t, result.Status, Solved)
+
+	if grid.LineString() != "483921657967345821251876493548132976729564138136798245372689514814253769695417382" {
+		t.Errorf("unexpected solution: %s", grid.LineString())
+	}
+}
+
+func TestSolveWithoutUniqueness(t *testing.T) {
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadLineString("4.3921.579.7345.21251876493548132976729564138136798245372689514814253769695417382"))
+
+	result := Solve(grid, strategies.WithoutUniqueness(DefaultStrategies()))
+	AssertNoError(t, result.Error)
+	assertStatus(t, result.Status, Stuck)
+
+	for _, step := range result.Steps {
+		if step.AssumesUniqueness {
+			t.Errorf("unexpected step assuming uniqueness: %s", step)
+		}
+	}
+}
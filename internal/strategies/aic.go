@@ -0,0 +1,67 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+const max_aic_length = 13
+
+func getAICGraph(grid *sudoku.Grid) *chainGraph {
+	sets := grid.GetSets()
+	graph := newChainGraph()
+
+	for digit := 1; digit <= 9; digit++ {
+		graph.addHouseLinks(sets, digit, false)
+	}
+	all_cells := grid.GetAllCells()
+	graph.addCellLinks(all_cells[:])
+
+	return graph
+}
+
+// applyAIC removes every candidate seeing both ends of the chain, as one of
+// them holds. Type 1 chains end on the same digit, type 2 chains end on two
+// different digits in cells seeing each other.
+func applyAIC(grid *sudoku.Grid, chain *chainPath) *Step {
+	first := chain.nodes[0]
+	last := chain.nodes[len(chain.nodes)-1]
+
+	aic_type := 1
+	if first.digit != last.digit {
+		aic_type = 2
+	}
+
+	step := chain.newStep("AIC")
+	step.Description = fmt.Sprintf("%s; type %d, either (%d)%s or (%d)%s holds", chain.format(true), aic_type, first.digit, first, last.digit, last)
+
+	for _, cell := range grid.GetAllCells() {
+		if cell.GetValue() != sudoku.Empty {
+			continue
+		}
+
+		for _, digit := range cell.GetPencilMarks() {
+			if first.isSeenBy(cell, digit) && last.isSeenBy(cell, digit) {
+				step.eliminate(cell, digit)
+			}
+		}
+	}
+
+	if !step.hasChanges() {
+		return nil
+	}
+
+	return step
+}
+
+func AIC(grid *sudoku.Grid) (*Step, error) {
+	var step *Step
+
+	getAICGraph(grid).findChains(3, max_aic_length, func(chain *chainPath) bool {
+		step = applyAIC(grid, chain)
+		return step != nil
+	})
+
+	return step, nil
+}
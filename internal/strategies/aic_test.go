@@ -0,0 +1,294 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestAICType1(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1 2 3 ||       |       | 1 2 3 ||   2   |   2   | 1     ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+|| 7 8   |       | 7 8   ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2 3 | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1   3 ||       |       | 1 2 3 ||   2   |   2   | 1     ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+|| 7 8   |       | 7 8   ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2 3 | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := AIC(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "AIC: (2)r2c3=(2)r2c7-(8)r2c7=(8)r2c4-(8)r9c4=(2)r9c4-(2)r6c4=(2)r5c6; type 1, either (2)r2c3 or (2)r5c6 holds => r5c3<>2")
+}
+
+func TestAICType2(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |   5   ||
+|| 7   9 | 7   9 |       ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |  (7)  || 4     | 4 5   |   5   ||
+||       |       |       ||   8   |       |       ||   8   |   8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |       ||
+|| 7   9 | 7   9 |       ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |  (7)  || 4     | 4 5   |   5   ||
+||       |       |       ||   8   |       |       ||   8   |   8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := AIC(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "AIC: (5)r1c9=(5)r1c8-(1)r1c8=(1)r7c8-(1)r7c4=(7)r7c4-(7)r7c9=(7)r4c9; type 2, either (5)r1c9 or (7)r4c9 holds => r4c9<>5")
+}
+
+func TestAICType1NothingToEliminate(t *testing.T) {
+	// (5)r3c1=(5)r3c3-(5)r7c3=(5)r7c8 means either (5)r3c1 or (5)r7c8 holds, but
+	// no cell seeing both holds 5.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (9)  ||  (3)  |       |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |       ||       | 7     |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       | 1     | 1     || 1     |       |       ||
+||     6 |  (4)  |       ||  (5)  |       |     6 ||       |  (2)  |  (9)  ||
+|| 7     |       | 7     ||       | 7 8   |   8   || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||   5 6 |  (2)  |   5   ||     6 |       |  (4)  ||  (3)  |     6 |       ||
+|| 7     |       | 7     ||   8 9 | 7 8 9 |       ||       | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||     3 |       | 1   3 || 1     | 1 2   | 1 2   ||       |       |       ||
+||       |  (5)  |       ||     6 |       |     6 || 4     | 4     |       ||
+|| 7 8 9 |       | 7 8   ||   8 9 |   8 9 |   8 9 || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (6)  |  (2)  ||       |  (4)  |  (3)  ||       |  (1)  |  (5)  ||
+|| 7 8 9 |       |       ||   8 9 |       |       || 7 8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       || 1     |       |       ||       |       |       ||
+||       |       |  (4)  ||       |  (5)  |  (7)  ||  (2)  |  (3)  |  (6)  ||
+||   8 9 |     9 |       ||   8 9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1     | 1     ||       |       |       ||
+||  (2)  |  (7)  |   5   ||  (4)  |       |       ||  (6)  |   5   |  (3)  ||
+||       |       |   8   ||       |   8 9 |   8 9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       || 1     |       | 1     ||
+||  (4)  |  (3)  |       ||  (2)  |  (6)  |  (5)  ||       |       |       ||
+||       |       |   8   ||       |       |       || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       | 1     || 1     |       |       ||
+||   5   |       |  (6)  ||  (7)  |  (3)  |       || 4     | 4 5   |  (2)  ||
+||   8 9 |     9 |       ||       |       |   8   ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := AIC(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestAICType2NothingToEliminate(t *testing.T) {
+	// (1)r6c2=(1)r6c4-(8)r6c4=(8)r6c1 means either (1)r6c2 or (8)r6c1 holds, but
+	// r6c2 holds no 8 and r6c1 holds no 1.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (9)  ||  (3)  |       |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |       ||       | 7     |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       | 1     | 1     || 1     |       |       ||
+||     6 |  (4)  |       ||  (5)  |       |     6 ||       |  (2)  |  (9)  ||
+|| 7     |       | 7     ||       | 7 8   |   8   || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||   5 6 |  (2)  |   5   ||     6 |       |  (4)  ||  (3)  |     6 |       ||
+|| 7     |       | 7     ||   8 9 | 7 8 9 |       ||       | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||     3 |       | 1   3 || 1     | 1 2   | 1 2   ||       |       |       ||
+||       |  (5)  |       ||     6 |       |     6 || 4     | 4     |       ||
+|| 7 8 9 |       | 7 8   ||   8 9 |   8 9 |   8 9 || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (6)  |  (2)  ||       |  (4)  |  (3)  ||       |  (1)  |  (5)  ||
+|| 7 8 9 |       |       ||   8 9 |       |       || 7 8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       || 1     |       |       ||       |       |       ||
+||       |       |  (4)  ||       |  (5)  |  (7)  ||  (2)  |  (3)  |  (6)  ||
+||   8 9 |     9 |       ||   8 9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1     | 1     ||       |       |       ||
+||  (2)  |  (7)  |   5   ||  (4)  |       |       ||  (6)  |   5   |  (3)  ||
+||       |       |   8   ||       |   8 9 |   8 9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       || 1     |       | 1     ||
+||  (4)  |  (3)  |       ||  (2)  |  (6)  |  (5)  ||       |       |       ||
+||       |       |   8   ||       |       |       || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       | 1     || 1     |       |       ||
+||   5   |       |  (6)  ||  (7)  |  (3)  |       || 4     | 4 5   |  (2)  ||
+||   8 9 |     9 |       ||       |       |   8   ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := AIC(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
@@ -0,0 +1,266 @@
+package strategies
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+const max_als_chain_length = 5
+
+// almostLockedSet is N cells of a house holding N+1 candidates between them.
+// Removing any one of its digits would lock the rest into its cells.
+type almostLockedSet struct {
+	cells []*sudoku.Cell
+	mask  int
+	house *sudoku.Set
+}
+
+type restrictedCommon struct {
+	als   *almostLockedSet
+	digit int
+}
+
+// alsGraph indexes the almost locked sets of a grid by digit and collects the
+// restricted commons of each set the first time the chain search reaches it.
+type alsGraph struct {
+	almost_locked_sets []*almostLockedSet
+	by_digit           [9][]*almostLockedSet
+	links              map[*almostLockedSet][]restrictedCommon
+}
+
+// getALSCombinations returns every almost locked set of the house, leaving out
+// the excluded cells. All the empty cells of a house together never form one.
+func getALSCombinations(house *sudoku.Set, excluded []*sudoku.Cell) []*almostLockedSet {
+	house_empty_count := 0
+	empty_cells := []*sudoku.Cell{}
+	for _, cell := range house.Cells {
+		if cell.GetValue() != sudoku.Empty {
+			continue
+		}
+
+		house_empty_count++
+		if !cellsContain(excluded, cell) {
+			empty_cells = append(empty_cells, cell)
+		}
+	}
+
+	almost_locked_sets := []*almostLockedSet{}
+	for size := 1; size <= len(empty_cells) && size < house_empty_count; size++ {
+		for _, combination := range getCombinations(len(empty_cells), size) {
+			cells := []*sudoku.Cell{}
+			mask := 0
+			for _, i := range combination {
+				cells = append(cells, empty_cells[i])
+				mask |= getPencilMarkMask(empty_cells[i])
+			}
+
+			if countDigitsInMask(mask) == size+1 {
+				almost_locked_sets = append(almost_locked_sets, &almostLockedSet{cells, mask, house})
+			}
+		}
+	}
+
+	return almost_locked_sets
+}
+
+func getAlmostLockedSets(grid *sudoku.Grid) []*almostLockedSet {
+	almost_locked_sets := []*almostLockedSet{}
+	found := map[string]bool{}
+
+	for _, house := range grid.GetSets() {
+		for _, als := range getALSCombinations(house, nil) {
+			sortCells(als.cells)
+			key := formatCells(als.cells)
+			if found[key] {
+				continue
+			}
+			found[key] = true
+
+			almost_locked_sets = append(almost_locked_sets, als)
+		}
+	}
+
+	sort.SliceStable(almost_locked_sets, func(i int, j int) bool {
+		return len(almost_locked_sets[i].cells) < len(almost_locked_sets[j].cells)
+	})
+
+	return almost_locked_sets
+}
+
+func (a *almostLockedSet) String() string {
+	return fmt.Sprintf("%s %s", formatCells(a.cells), formatDigits(getDigitsFromMask(a.mask)))
+}
+
+func (a *almostLockedSet) getCellsWithDigit(digit int) []*sudoku.Cell {
+	return getCellsWithPencilMark(a.cells, digit)
+}
+
+func (a *almostLockedSet) overlaps(other *almostLockedSet) bool {
+	for _, cell := range a.cells {
+		if cellsContain(other.cells, cell) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRestrictedCommon tells whether every candidate of the digit in one set
+// sees every candidate of it in the other, so at most one of the sets can
+// hold it.
+func isRestrictedCommon(a *almostLockedSet, b *almostLockedSet, digit int) bool {
+	if a.overlaps(b) {
+		return false
+	}
+
+	other_cells := b.getCellsWithDigit(digit)
+	for _, cell := range a.getCellsWithDigit(digit) {
+		if !cellSeesAll(cell, other_cells) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newALSGraph(grid *sudoku.Grid) *alsGraph {
+	g := alsGraph{
+		getAlmostLockedSets(grid),
+		[9][]*almostLockedSet{},
+		make(map[*almostLockedSet][]restrictedCommon),
+	}
+
+	for _, als := range g.almost_locked_sets {
+		for _, digit := range getDigitsFromMask(als.mask) {
+			g.by_digit[digit-1] = append(g.by_digit[digit-1], als)
+		}
+	}
+
+	return &g
+}
+
+func (g *alsGraph) getLinks(als *almostLockedSet) []restrictedCommon {
+	if links, found := g.links[als]; found {
+		return links
+	}
+
+	links := []restrictedCommon{}
+	for _, digit := range getDigitsFromMask(als.mask) {
+		for _, other_als := range g.by_digit[digit-1] {
+			if isRestrictedCommon(als, other_als, digit) {
+				links = append(links, restrictedCommon{other_als, digit})
+			}
+		}
+	}
+	g.links[als] = links
+
+	return links
+}
+
+// applyALSChain eliminates the digits the two ends have in common besides
+// the restricted commons touching them. If the first set does not hold such a
+// digit z, it is locked and holds its restricted common, which then forces
+// the next set to lock, and so on until the last set, which then holds z.
+func applyALSChain(grid *sudoku.Grid, technique string, chain []*almostLockedSet, digits []int) *Step {
+	first := chain[0]
+	last := chain[len(chain)-1]
+	z_mask := first.mask & last.mask &^ (1 << (digits[0] - 1)) &^ (1 << (digits[len(digits)-1] - 1))
+
+	names := []string{}
+	for i, als := range chain {
+		names = append(names, fmt.Sprintf("%c: %s", 'A'+i, als))
+	}
+
+	commons := "restricted common"
+	if len(digits) > 1 {
+		commons += "s"
+	}
+	digit_strings := []string{}
+	for _, digit := range digits {
+		digit_strings = append(digit_strings, fmt.Sprint(digit))
+	}
+
+	for _, z := range getDigitsFromMask(z_mask) {
+		step := newStep(technique)
+		step.Description = fmt.Sprintf("%s; %s %s, so A or %c holds %d", strings.Join(names, ", "), commons, strings.Join(digit_strings, ", "), 'A'+len(chain)-1, z)
+
+		z_cells := append(first.getCellsWithDigit(z), last.getCellsWithDigit(z)...)
+		for _, als := range chain {
+			step.Cells = append(step.Cells, als.cells...)
+			if !setsContain(step.Houses, als.house) {
+				step.Houses = append(step.Houses, als.house)
+			}
+		}
+
+		eliminateFromCellsSeeingAll(step, grid, z_cells, z)
+
+		if step.hasChanges() {
+			return step
+		}
+	}
+
+	return nil
+}
+
+func findALSChain(grid *sudoku.Grid, graph *alsGraph, technique string, length int) *Step {
+	var extend func(chain []*almostLockedSet, digits []int) *Step
+	extend = func(chain []*almostLockedSet, digits []int) *Step {
+		if len(chain) == length {
+			return applyALSChain(grid, technique, chain, digits)
+		}
+
+		for _, link := range graph.getLinks(chain[len(chain)-1]) {
+			if len(digits) > 0 && link.digit == digits[len(digits)-1] {
+				continue
+			}
+
+			overlaps := false
+			for _, als := range chain {
+				if als.overlaps(link.als) {
+					overlaps = true
+					break
+				}
+			}
+			if overlaps {
+				continue
+			}
+
+			if step := extend(append(chain, link.als), append(digits, link.digit)); step != nil {
+				return step
+			}
+		}
+
+		return nil
+	}
+
+	for _, als := range graph.almost_locked_sets {
+		if step := extend([]*almostLockedSet{als}, []int{}); step != nil {
+			return step
+		}
+	}
+
+	return nil
+}
+
+func ALSXZ(grid *sudoku.Grid) (*Step, error) {
+	return findALSChain(grid, newALSGraph(grid), "ALS-XZ", 2), nil
+}
+
+func ALSXYWing(grid *sudoku.Grid) (*Step, error) {
+	return findALSChain(grid, newALSGraph(grid), "ALS-XY-Wing", 3), nil
+}
+
+func ALSChain(grid *sudoku.Grid) (*Step, error) {
+	graph := newALSGraph(grid)
+
+	for length := 4; length <= max_als_chain_length; length++ {
+		if step := findALSChain(grid, graph, "ALS Chain", length); step != nil {
+			return step, nil
+		}
+	}
+
+	return nil, nil
+}
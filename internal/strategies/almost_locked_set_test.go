@@ -0,0 +1,532 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestALSXZ(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1   3 ||       |       | 1 2 3 ||   2   |   2   | 1     ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+|| 7 8   |       | 7 8   ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2 3 | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1   3 ||       |       | 1 2 3 ||   2   |   2   | 1     ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2 3 | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := ALSXZ(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "ALS-XZ: A: r7c2, r8c3 {1, 4, 8}, B: r6c2, r6c4, r6c5, r6c9 {1, 2, 4, 6, 8}; restricted common 4, so A or B holds 8 => r6c3<>8")
+}
+
+func TestALSXYWing(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (9)  ||  (3)  |       |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |       ||       | 7     |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       | 1     | 1     || 1     |       |       ||
+||     6 |  (4)  |       ||  (5)  |       |     6 ||       |  (2)  |  (9)  ||
+|| 7     |       | 7     ||       | 7 8   |   8   || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||   5 6 |  (2)  |   5   ||     6 |       |  (4)  ||  (3)  |     6 |       ||
+|| 7     |       | 7     ||     9 | 7 8 9 |       ||       | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||     3 |       | 1   3 || 1     | 1 2   | 1 2   ||       |       |       ||
+||       |  (5)  |       ||     6 |       |     6 || 4     | 4     |       ||
+||   8 9 |       | 7 8   ||   8 9 |     9 |     9 || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (6)  |  (2)  ||       |  (4)  |  (3)  ||       |  (1)  |  (5)  ||
+|| 7 8 9 |       |       ||   8 9 |       |       || 7 8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       || 1     |       |       ||       |       |       ||
+||       |       |  (4)  ||       |  (5)  |  (7)  ||  (2)  |  (3)  |  (6)  ||
+||   8 9 |     9 |       ||   8 9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1     | 1     ||       |       |       ||
+||  (2)  |  (7)  |   5   ||  (4)  |       |       ||  (6)  |   5   |  (3)  ||
+||       |       |   8   ||       |   8 9 |   8 9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       || 1     |       | 1     ||
+||  (4)  |  (3)  |       ||  (2)  |  (6)  |  (5)  ||       |       |       ||
+||       |       |   8   ||       |       |       || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       | 1     || 1     |       |       ||
+||   5   |       |  (6)  ||  (7)  |  (3)  |       || 4     | 4 5   |  (2)  ||
+||   8 9 |     9 |       ||       |       |   8   ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (9)  ||  (3)  |       |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |       ||       | 7     |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       | 1     | 1     || 1     |       |       ||
+||     6 |  (4)  |       ||  (5)  |       |     6 ||       |  (2)  |  (9)  ||
+|| 7     |       | 7     ||       | 7 8   |   8   || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||   5 6 |  (2)  |   5   ||     6 |       |  (4)  ||  (3)  |     6 |       ||
+|| 7     |       | 7     ||     9 | 7 8 9 |       ||       | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||     3 |       | 1   3 || 1     | 1 2   | 1 2   ||       |       |       ||
+||       |  (5)  |       ||     6 |       |     6 || 4     | 4     |       ||
+||   8 9 |       | 7 8   ||   8 9 |     9 |     9 || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (6)  |  (2)  ||       |  (4)  |  (3)  ||       |  (1)  |  (5)  ||
+|| 7 8 9 |       |       ||   8 9 |       |       || 7 8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       || 1     |       |       ||       |       |       ||
+||       |       |  (4)  ||       |  (5)  |  (7)  ||  (2)  |  (3)  |  (6)  ||
+||   8 9 |     9 |       ||   8 9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1     | 1     ||       |       |       ||
+||  (2)  |  (7)  |   5   ||  (4)  |       |       ||  (6)  |   5   |  (3)  ||
+||       |       |   8   ||       |   8 9 |   8 9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       || 1     |       | 1     ||
+||  (4)  |  (3)  |       ||  (2)  |  (6)  |  (5)  ||       |       |       ||
+||       |       |   8   ||       |       |       || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       | 1     || 1     |       |       ||
+||   5   |       |  (6)  ||  (7)  |  (3)  |       || 4     | 4 5   |  (2)  ||
+||     9 |     9 |       ||       |       |   8   ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := ALSXYWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "ALS-XY-Wing: A: r8c3 {1, 8}, B: r7c8, r8c7, r8c8, r8c9 {1, 5, 7, 8, 9}, C: r9c6, r9c7, r9c8 {1, 4, 5, 8}; restricted commons 1, 5, so A or C holds 8 => r9c1<>8")
+}
+
+func TestALSChain(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1   3 ||       |       | 1 2 3 ||   2   |   2   | 1     ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+||   8   |       | 7     ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2   | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1   3 ||       |       | 1 2 3 ||   2   |   2   | 1     ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   | 1     || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+||   8   |       | 7     ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2   | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := ALSChain(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "ALS Chain: A: r2c3 {2, 3}, B: r2c4, r9c4 {2, 3, 8}, C: r9c9 {2, 8}, D: r6c4, r6c5, r6c9 {1, 2, 6, 8}; restricted commons 3, 2, 8, so A or D holds 2 => r6c3<>2")
+}
+
+func TestALSXZLargeSet(t *testing.T) {
+	// B has five cells, and no ALS-XZ of sets of at most four cells eliminates
+	// anything here.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1     | 1 2   |   2   ||       |       | 1     ||       |   2   |       ||
+||   5   |       | 4     ||  (3)  |  (6)  |       ||  (7)  | 4 5   | 4 5   ||
+||   8   |   8 9 |   8 9 ||       |       |   8 9 ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1     |       ||       | 1     |       ||     3 |     3 |       ||
+||   5 6 |     6 | 4   6 ||  (7)  |   5   |  (2)  || 4 5 6 | 4 5 6 |  (8)  ||
+||       |     9 |     9 ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |   2   ||       |       |       ||   2 3 |   2 3 |       ||
+||   5 6 |     6 |     6 ||  (4)  |   5   |       ||   5 6 |   5 6 |  (1)  ||
+|| 7 8   | 7 8 9 | 7 8 9 ||       |   8   |   8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       |   2   |   2 3 ||   2   |       |       ||     3 |       |       ||
+||  (4)  |   5 6 |     6 ||     6 |  (9)  |  (7)  ||   5 6 |  (1)  |   5 6 ||
+||       |   8   |   8   ||       |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||       | 1     | 1     ||     3 |     3 |       ||
+||  (9)  |   5   |     6 ||  (8)  | 4     |     6 || 4 5 6 | 4 5 6 |  (2)  ||
+||       | 7     | 7     ||       |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||       |   2   |       ||       |       |       ||
+||     6 |     6 |  (1)  ||  (5)  | 4     |  (3)  || 4   6 | 4   6 |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       ||   8 9 | 7 8   | 7   9 ||
+##=======================##=======================##=======================##
+|| 1     | 1     |       ||   2   |       |       ||   2   |   2   |       ||
+||     6 |     6 |     6 ||     6 |  (3)  |  (4)  ||   5 6 |   5 6 |   5 6 ||
+|| 7 8   | 7 8 9 | 7 8 9 ||       |       |       ||   8   | 7 8   | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       ||       |   2   |       ||
+||     6 |  (3)  |  (5)  ||  (9)  |       |     6 ||  (1)  | 4   6 | 4   6 ||
+|| 7     |       |       ||       |   8   |   8   ||       | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (4)  |     6 ||  (1)  |  (7)  |  (5)  ||     6 |  (9)  |  (3)  ||
+||       |       |   8   ||       |       |       ||   8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1     | 1 2   |   2   ||       |       | 1     ||       |   2   |       ||
+||   5   |       | 4     ||  (3)  |  (6)  |       ||  (7)  | 4 5   | 4 5   ||
+||   8   |   8 9 |   8 9 ||       |       |   8 9 ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1     |       ||       | 1     |       ||     3 |     3 |       ||
+||   5 6 |     6 | 4   6 ||  (7)  |   5   |  (2)  || 4   6 | 4 5 6 |  (8)  ||
+||       |     9 |     9 ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |   2   ||       |       |       ||   2 3 |   2 3 |       ||
+||   5 6 |     6 |     6 ||  (4)  |   5   |       ||   5 6 |   5 6 |  (1)  ||
+|| 7 8   | 7 8 9 | 7 8 9 ||       |   8   |   8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       |   2   |   2 3 ||   2   |       |       ||     3 |       |       ||
+||  (4)  |   5 6 |     6 ||     6 |  (9)  |  (7)  ||   5 6 |  (1)  |   5 6 ||
+||       |   8   |   8   ||       |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||       | 1     | 1     ||     3 |     3 |       ||
+||  (9)  |   5   |     6 ||  (8)  | 4     |     6 || 4 5 6 | 4 5 6 |  (2)  ||
+||       | 7     | 7     ||       |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||       |   2   |       ||       |       |       ||
+||     6 |     6 |  (1)  ||  (5)  | 4     |  (3)  || 4   6 | 4   6 |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       ||   8 9 | 7 8   | 7   9 ||
+##=======================##=======================##=======================##
+|| 1     | 1     |       ||   2   |       |       ||   2   |   2   |       ||
+||     6 |     6 |     6 ||     6 |  (3)  |  (4)  ||   5 6 |   5 6 |   5 6 ||
+|| 7 8   | 7 8 9 | 7 8 9 ||       |       |       ||   8   | 7 8   | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       ||       |   2   |       ||
+||     6 |  (3)  |  (5)  ||  (9)  |       |     6 ||  (1)  | 4   6 | 4   6 ||
+|| 7     |       |       ||       |   8   |   8   ||       | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (4)  |     6 ||  (1)  |  (7)  |  (5)  ||     6 |  (9)  |  (3)  ||
+||       |       |   8   ||       |       |       ||   8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := ALSXZ(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "ALS-XZ: A: r2c5, r5c5 {1, 4, 5}, B: r3c7, r4c7, r5c7, r7c7, r9c7 {2, 3, 4, 5, 6, 8}; restricted common 4, so A or B holds 5 => r2c7<>5")
+}
+
+func TestALSXZNothingToEliminate(t *testing.T) {
+	// A: r1c5 {2, 7} and B: r1c6, r1c8 {2, 6, 7} have the restricted commons 2
+	// and 7, but no other cell of row 1 holds either digit and no other cell
+	// of box 2 holds 2.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (9)  ||  (3)  |       |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |       ||       | 7     |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       | 1     | 1     || 1     |       |       ||
+||     6 |  (4)  |       ||  (5)  |       |     6 ||       |  (2)  |  (9)  ||
+|| 7     |       | 7     ||       | 7 8   |   8   || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||   5 6 |  (2)  |   5   ||     6 |       |  (4)  ||  (3)  |     6 |       ||
+|| 7     |       | 7     ||   8 9 | 7 8 9 |       ||       | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||     3 |       | 1   3 || 1     | 1 2   | 1 2   ||       |       |       ||
+||       |  (5)  |       ||     6 |       |     6 || 4     | 4     |       ||
+|| 7 8 9 |       | 7 8   ||   8 9 |   8 9 |   8 9 || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (6)  |  (2)  ||       |  (4)  |  (3)  ||       |  (1)  |  (5)  ||
+|| 7 8 9 |       |       ||   8 9 |       |       || 7 8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       || 1     |       |       ||       |       |       ||
+||       |       |  (4)  ||       |  (5)  |  (7)  ||  (2)  |  (3)  |  (6)  ||
+||   8 9 |     9 |       ||   8 9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1     | 1     ||       |       |       ||
+||  (2)  |  (7)  |   5   ||  (4)  |       |       ||  (6)  |   5   |  (3)  ||
+||       |       |   8   ||       |   8 9 |   8 9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       || 1     |       | 1     ||
+||  (4)  |  (3)  |       ||  (2)  |  (6)  |  (5)  ||       |       |       ||
+||       |       |   8   ||       |       |       || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       | 1     || 1     |       |       ||
+||   5   |       |  (6)  ||  (7)  |  (3)  |       || 4     | 4 5   |  (2)  ||
+||   8 9 |     9 |       ||       |       |   8   ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := ALSXZ(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestALSXYWingNothingToEliminate(t *testing.T) {
+	// A: r1c8 {6, 9}, B: r1c7 {3, 6}, C: r1c4, r3c5 {1, 3, 9} force 9 into A or
+	// C, but r1c5 and r1c6, the only cells seeing all of their 9s, are solved.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1   3 ||       |       | 1 2 3 ||   2   |   2   | 1     ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+||   8   |       | 7     ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2   | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := ALSXYWing(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestALSChainNothingToEliminate(t *testing.T) {
+	// A: r4c8, r6c8 {1, 3, 6}, B: r9c8 {1, 6}, C: r9c7 {5, 6}, D: r1c7, r4c7,
+	// r5c7, r6c7, r7c7 {2, 3, 4, 5, 6, 8} force 3 into A or D, but every cell
+	// seeing all of their 3s belongs to D.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||     3 |       |       ||
+||  (5)  |  (1)  |  (2)  || 4   6 |  (7)  | 4   6 || 4   6 |  (9)  | 4   6 ||
+||       |       |       ||   8   |       |   8   ||   8   |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       |       ||
+||  (9)  |  (3)  |  (6)  || 4 5   | 4 5   | 4 5   ||  (7)  |  (2)  | 4     ||
+||       |       |       ||   8   |       |   8   ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (4)  |  (8)  ||  (2)  |     6 |  (3)  ||  (1)  |  (5)  |  (6)  ||
+||       |       |       ||       |     9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       | 1   3 ||     3 |       |       ||     3 | 1   3 | 1     ||
+||  (2)  |     6 |       || 4 5 6 |  (8)  | 4 5 6 || 4 5 6 |     6 | 4 5 6 ||
+||       | 7   9 | 7   9 || 7     |       | 7     ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||     3 |   2   |       ||   2 3 |       |   2   ||
+||     6 |     6 |  (4)  ||   5 6 |   5 6 |  (1)  ||   5 6 |  (7)  |   5 6 ||
+||   8   |   8 9 |       ||       |       |       ||   8   |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 ||     3 |   2   |       ||   2 3 | 1   3 | 1 2   ||
+||     6 |  (5)  |       || 4   6 | 4   6 |  (9)  || 4   6 |     6 | 4   6 ||
+||   8   |       | 7     || 7     |       |       ||   8   |       |   8   ||
+##=======================##=======================##=======================##
+|| 1     |       | 1     || 1     |       |       ||   2   |       | 1 2   ||
+||     6 |     6 |   5   ||   5 6 |  (3)  |   5 6 ||   5 6 |  (4)  |   5 6 ||
+||   8   | 7 8 9 | 7   9 || 7 8   |       | 7 8   ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1     | 1     |       ||       |       | 1     ||
+|| 4   6 |     6 |   5   || 4 5 6 | 4 5 6 |  (2)  ||  (9)  |  (8)  |   5 6 ||
+||       | 7     | 7     || 7     |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       | 1     |       ||       | 1     |       ||
+|| 4   6 |  (2)  |   5   ||  (9)  | 4 5 6 | 4 5 6 ||   5 6 |     6 |  (3)  ||
+||   8   |       | 7     ||       |       | 7 8   ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := ALSChain(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
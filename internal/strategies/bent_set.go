@@ -0,0 +1,127 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+func getBentSetHousePairs(sets [27]*sudoku.Set) [][2]*sudoku.Set {
+	house_pairs := [][2]*sudoku.Set{}
+
+	for _, box := range sets[18:] {
+		for _, line := range sets[:18] {
+			for _, cell := range line.Cells {
+				if cell.GetBoxId() == box.Index {
+					house_pairs = append(house_pairs, [2]*sudoku.Set{box, line})
+					break
+				}
+			}
+		}
+	}
+
+	return house_pairs
+}
+
+// getNonRestrictedDigits returns the digits of the cells whose instances do not
+// all see each other.
+func getNonRestrictedDigits(cells []*sudoku.Cell, mask int) []int {
+	non_restricted_digits := []int{}
+
+	for _, digit := range getDigitsFromMask(mask) {
+		cells_with_digit := getCellsWithPencilMark(cells, digit)
+
+		restricted := true
+		for _, combination := range getCombinations(len(cells_with_digit), 2) {
+			if !cellsSeeEachOther(cells_with_digit[combination[0]], cells_with_digit[combination[1]]) {
+				restricted = false
+				break
+			}
+		}
+
+		if !restricted {
+			non_restricted_digits = append(non_restricted_digits, digit)
+		}
+	}
+
+	return non_restricted_digits
+}
+
+func findBentSetInHouses(grid *sudoku.Grid, technique string, box *sudoku.Set, line *sudoku.Set, size int) *Step {
+	candidate_cells := []*sudoku.Cell{}
+	for _, set := range []*sudoku.Set{box, line} {
+		for _, cell := range set.Cells {
+			if cell.GetValue() == sudoku.Empty && len(cell.GetPencilMarks()) <= size && !cellsContain(candidate_cells, cell) {
+				candidate_cells = append(candidate_cells, cell)
+			}
+		}
+	}
+
+	for _, combination := range getCombinations(len(candidate_cells), size) {
+		cells := []*sudoku.Cell{}
+		mask := 0
+		outside_box := false
+		outside_line := false
+
+		for _, index := range combination {
+			cell := candidate_cells[index]
+			cells = append(cells, cell)
+			mask |= getPencilMarkMask(cell)
+
+			outside_box = outside_box || cell.GetBoxId() != box.Index
+			outside_line = outside_line || !cellsContain(line.Cells[:], cell)
+		}
+
+		if !outside_box || !outside_line || countDigitsInMask(mask) != size {
+			continue
+		}
+
+		non_restricted_digits := getNonRestrictedDigits(cells, mask)
+		if len(non_restricted_digits) != 1 {
+			continue
+		}
+
+		z := non_restricted_digits[0]
+		cells_with_z := getCellsWithPencilMark(cells, z)
+
+		sortCells(cells)
+
+		step := newStep(technique)
+		step.Description = fmt.Sprintf("%s hold %s in %s and %s, every digit but %d is restricted", formatCells(cells), formatDigits(getDigitsFromMask(mask)), box, line, z)
+		step.Houses = append(step.Houses, box, line)
+		step.Cells = append(step.Cells, cells...)
+
+		eliminateFromCellsSeeingAll(step, grid, cells_with_z, z)
+
+		if step.hasChanges() {
+			return step
+		}
+	}
+
+	return nil
+}
+
+func findBentSet(grid *sudoku.Grid, technique string, size int) (*Step, error) {
+	for _, house_pair := range getBentSetHousePairs(grid.GetSets()) {
+		if step := findBentSetInHouses(grid, technique, house_pair[0], house_pair[1], size); step != nil {
+			return step, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func WXYZWing(grid *sudoku.Grid) (*Step, error) {
+	return findBentSet(grid, "WXYZ-Wing", 4)
+}
+
+func BentSet(grid *sudoku.Grid) (*Step, error) {
+	for size := 5; size <= 6; size++ {
+		step, err := findBentSet(grid, "Bent Set", size)
+		if err != nil || step != nil {
+			return step, err
+		}
+	}
+
+	return nil, nil
+}
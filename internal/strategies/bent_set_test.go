@@ -0,0 +1,295 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestWXYZWing(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |   5   ||
+|| 7   9 | 7   9 |       ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |  (7)  || 4     | 4 5   |   5   ||
+||       |       |       ||   8   |       |       ||   8   |   8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |   5   ||
+|| 7   9 | 7   9 |       ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |  (7)  || 4     | 4 5   |   5   ||
+||       |       |       ||   8   |       |       ||   8   |   8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := WXYZWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "WXYZ-Wing: r1c9, r2c8, r3c8, r4c9 hold {4, 5, 7, 8} in box 3 and column 9, every digit but 7 is restricted => r2c9<>7, r3c9<>7")
+}
+
+func TestBentSet(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (6)  |  (2)  |  (5)  ||  (9)  |  (7)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (6)  ||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||   5 6 |   5 6 |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+|| 1     |       |   2   || 1 2   |       |   2   || 1     | 1 2   |       ||
+||       |  (3)  |       ||       |  (6)  | 4     ||       | 4     |  (5)  ||
+||     9 |       |   8   ||   8   |       | 7     || 7 8   |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1 2   |     3 |       || 1   3 | 1 2 3 |       ||
+||  (5)  |  (7)  |       ||       | 4     |  (9)  ||       | 4     |  (6)  ||
+||       |       |   8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |     3 |   2   || 1   3 | 1 2 3 |       ||
+||       |  (6)  |  (4)  ||  (5)  |       |       ||       |       |       ||
+||     9 |       |       ||       | 7     | 7 8   || 7 8   |     9 | 7   9 ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       ||       | 1     |       ||
+||  (6)  |       |       ||  (3)  |  (5)  |       ||  (2)  |       |  (4)  ||
+||       |   8   | 7   9 ||       |       | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   |       |   2   || 1     | 1     |       ||
+||  (3)  |       |       ||       | 4     | 4   6 ||   5 6 |   5 6 |  (8)  ||
+||       |       | 7   9 ||     9 | 7     | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||   2   |       |       ||     3 |     3 |       ||
+||  (4)  |       |  (5)  ||       |  (1)  |     6 ||     6 |     6 |       ||
+||       |   8   |       ||   8 9 |       |   8   || 7     |     9 | 7   9 ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (6)  |  (2)  |  (5)  ||  (9)  |  (7)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (6)  ||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||   5 6 |   5 6 |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+|| 1     |       |   2   || 1 2   |       |   2   || 1     | 1 2   |       ||
+||       |  (3)  |       ||       |  (6)  | 4     ||       | 4     |  (5)  ||
+||     9 |       |   8   ||   8   |       | 7     || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1 2   |     3 |       || 1   3 | 1 2 3 |       ||
+||  (5)  |  (7)  |       ||       | 4     |  (9)  ||       | 4     |  (6)  ||
+||       |       |   8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |     3 |   2   || 1   3 | 1 2 3 |       ||
+||       |  (6)  |  (4)  ||  (5)  |       |       ||       |       |       ||
+||       |       |       ||       | 7     | 7 8   || 7 8   |     9 | 7   9 ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       ||       | 1     |       ||
+||  (6)  |       |       ||  (3)  |  (5)  |       ||  (2)  |       |  (4)  ||
+||       |   8   | 7   9 ||       |       | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   |       |   2   || 1     | 1     |       ||
+||  (3)  |       |       ||       | 4     | 4   6 ||   5 6 |   5 6 |  (8)  ||
+||       |       | 7   9 ||     9 | 7     | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||   2   |       |       ||     3 |     3 |       ||
+||  (4)  |       |  (5)  ||       |  (1)  |     6 ||     6 |     6 |       ||
+||       |   8   |       ||   8 9 |       |   8   || 7     |     9 | 7   9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := BentSet(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Bent Set: r4c1, r4c3, r4c4, r4c7, r6c9 hold {1, 2, 7, 8, 9} in box 6 and row 4, every digit but 9 is restricted => r4c8<>9, r6c1<>9")
+}
+
+func TestWXYZWingTwoNonRestrictedDigits(t *testing.T) {
+	// r3c7, r3c8, r7c8 and r8c8 hold {1, 5, 6, 9} in box 3 and column 8, but
+	// both 5 and 6 are non-restricted.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (6)  |  (2)  |  (5)  ||  (9)  |  (7)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (6)  ||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||   5 6 |   5 6 |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+|| 1     |       |   2   || 1 2   |       |   2   || 1     | 1 2   |       ||
+||       |  (3)  |       ||       |  (6)  | 4     ||       | 4     |  (5)  ||
+||     9 |       |   8   ||   8   |       | 7     || 7 8   |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1 2   |     3 |       || 1   3 | 1 2 3 |       ||
+||  (5)  |  (7)  |       ||       | 4     |  (9)  ||       | 4     |  (6)  ||
+||       |       |   8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |     3 |   2   || 1   3 | 1 2 3 |       ||
+||       |  (6)  |  (4)  ||  (5)  |       |       ||       |       |       ||
+||     9 |       |       ||       | 7     | 7 8   || 7 8   |     9 | 7   9 ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       ||       | 1     |       ||
+||  (6)  |       |       ||  (3)  |  (5)  |       ||  (2)  |       |  (4)  ||
+||       |   8   | 7   9 ||       |       | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   |       |   2   || 1     | 1     |       ||
+||  (3)  |       |       ||       | 4     | 4   6 ||   5 6 |   5 6 |  (8)  ||
+||       |       | 7   9 ||     9 | 7     | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||   2   |       |       ||     3 |     3 |       ||
+||  (4)  |       |  (5)  ||       |  (1)  |     6 ||     6 |     6 |       ||
+||       |   8   |       ||   8 9 |       |   8   || 7     |     9 | 7   9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := WXYZWing(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestBentSetNothingToEliminate(t *testing.T) {
+	// r1c1, r1c3, r2c2, r2c3 and r2c7 hold {1, 5, 6, 7, 8} in box 1 and row 2
+	// and only 6 is non-restricted, but no other cell seeing all their 6s holds
+	// 6.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1     |       | 1     ||       |       |       ||       | 1   3 | 1   3 ||
+||       |  (4)  |     6 ||  (5)  |  (7)  |  (9)  ||  (2)  |     6 |       ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     || 1 2   |       |   2   ||       |       |       ||
+||  (3)  |   5 6 |   5 6 ||     6 | 4   6 | 4     ||   5 6 |  (8)  |  (9)  ||
+||       | 7     | 7     ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     || 1     |       |       ||       | 1     |       ||
+||  (9)  |  (2)  |   5 6 ||     6 |  (8)  |  (3)  ||  (4)  |   5 6 |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       | 1     | 1     ||
+||  (7)  |   5 6 |  (3)  ||  (4)  |  (2)  |     6 ||   5 6 |   5 6 |       ||
+||       |     9 |       ||       |       |   8   ||     9 |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||  (4)  |  (1)  |  (2)  ||  (9)  |  (5)  |     6 ||  (7)  |     6 |       ||
+||       |       |       ||       |       |   8   ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |   5 6 |   5 6 ||  (3)  |  (1)  |  (7)  ||   5 6 |  (4)  |  (2)  ||
+||   8   |   8 9 |   8 9 ||       |       |       ||     9 |       |       ||
+##=======================##=======================##=======================##
+||   2   |       |       ||   2   |     3 |       ||     3 |   2   |       ||
+||   5   |   5   | 4 5   ||       | 4     |  (1)  ||       |       |  (6)  ||
+||       |     9 |     9 || 7 8   |       |       ||   8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |   2   |       ||
+||  (6)  |  (3)  |       ||       |  (9)  |  (5)  ||  (1)  |       |  (4)  ||
+||       |       | 7 8   || 7 8   |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   |       | 1     ||       |     3 |   2   ||     3 |       |       ||
+||       |       | 4     ||     6 |     6 | 4     ||       |  (9)  |  (5)  ||
+||       | 7 8   |       || 7 8   |       |       ||   8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := BentSet(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
@@ -0,0 +1,155 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+func getHousesOfCell(sets [27]*sudoku.Set, cell *sudoku.Cell) [3]*sudoku.Set {
+	return [3]*sudoku.Set{
+		sets[cell.GetRowId()-1],
+		sets[cell.GetColumnId()-1+9],
+		sets[cell.GetBoxId()-1+18],
+	}
+}
+
+// getBUGCandidates returns the candidates whose removal would leave a
+// bivalue universal grave: every unsolved cell bivalue and every digit twice
+// in each house it is not placed in. Such a grid has either no or two
+// solutions, so with a unique solution one of these candidates holds. It
+// returns nil if there is no such set of candidates.
+func getBUGCandidates(grid *sudoku.Grid) []candidate {
+	sets := grid.GetSets()
+	candidates := []candidate{}
+
+	for _, cell := range grid.GetAllCells() {
+		if cell.GetValue() != sudoku.Empty || len(cell.GetPencilMarks()) <= 2 {
+			continue
+		}
+
+		for _, digit := range cell.GetPencilMarks() {
+			extra := true
+			for _, house := range getHousesOfCell(sets, cell) {
+				if len(getCellsWithPencilMark(house.Cells[:], digit)) <= 2 {
+					extra = false
+				}
+			}
+
+			if extra {
+				candidates = append(candidates, candidate{cell, digit})
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	is_remaining := func(cell *sudoku.Cell, digit int) bool {
+		for _, other_candidate := range candidates {
+			if other_candidate.cell == cell && other_candidate.digit == digit {
+				return false
+			}
+		}
+
+		return pencilMarksContainDigit(cell.GetPencilMarks(), digit)
+	}
+
+	for _, cell := range grid.GetAllCells() {
+		if cell.GetValue() != sudoku.Empty {
+			continue
+		}
+
+		remaining_count := 0
+		for _, digit := range cell.GetPencilMarks() {
+			if is_remaining(cell, digit) {
+				remaining_count++
+			}
+		}
+
+		if remaining_count != 2 {
+			return nil
+		}
+	}
+
+	for _, set := range sets {
+		for digit := 1; digit <= 9; digit++ {
+			remaining_count := 0
+			for _, cell := range getCellsWithPencilMark(set.Cells[:], digit) {
+				if is_remaining(cell, digit) {
+					remaining_count++
+				}
+			}
+
+			if remaining_count != 0 && remaining_count != 2 {
+				return nil
+			}
+		}
+	}
+
+	return candidates
+}
+
+func BUGPlus1(grid *sudoku.Grid) (*Step, error) {
+	candidates := getBUGCandidates(grid)
+	if len(candidates) != 1 {
+		return nil, nil
+	}
+
+	cell := candidates[0].cell
+	digit := candidates[0].digit
+
+	step := newStep("BUG+1")
+	step.Description = fmt.Sprintf("every other unsolved cell is bivalue, %s must hold %d to avoid a bivalue universal grave", cell, digit)
+	step.AssumesUniqueness = true
+	step.Cells = append(step.Cells, cell)
+	step.place(cell, digit)
+
+	return step, nil
+}
+
+// BUGPlusN eliminates the candidates seeing every candidate that breaks the
+// grave, as at least one of those holds.
+func BUGPlusN(grid *sudoku.Grid) (*Step, error) {
+	candidates := getBUGCandidates(grid)
+	if len(candidates) < 2 {
+		return nil, nil
+	}
+
+	step := newStep("BUG+n")
+	step.Description = fmt.Sprintf("one of %s holds to avoid a bivalue universal grave", formatCandidates(candidates))
+	step.AssumesUniqueness = true
+
+	for _, bug_candidate := range candidates {
+		if !cellsContain(step.Cells, bug_candidate.cell) {
+			step.Cells = append(step.Cells, bug_candidate.cell)
+		}
+	}
+
+	for _, cell := range grid.GetAllCells() {
+		if cell.GetValue() != sudoku.Empty {
+			continue
+		}
+
+		for _, digit := range cell.GetPencilMarks() {
+			seen_by_all := true
+			for _, bug_candidate := range candidates {
+				if !bug_candidate.sees(candidate{cell, digit}) {
+					seen_by_all = false
+					break
+				}
+			}
+
+			if seen_by_all {
+				step.eliminate(cell, digit)
+			}
+		}
+	}
+
+	if !step.hasChanges() {
+		return nil, nil
+	}
+
+	return step, nil
+}
@@ -0,0 +1,294 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestBUGPlus1(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||     3 |       |       ||       |       |       ||
+||  (4)  |       |  (9)  ||       |  (6)  |  (8)  ||  (2)  |  (5)  |  (1)  ||
+||       | 7     |       || 7     |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |       ||       |     3 |       ||
+||  (1)  |  (8)  |  (2)  ||  (5)  | 4     | 4     ||  (6)  |       |  (7)  ||
+||       |       |       ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |       |       ||       |     3 |       ||
+||  (5)  |  (6)  |       ||       |  (1)  |  (2)  ||  (4)  |       |  (8)  ||
+||       |       | 7     || 7   9 |       |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (2)  | 4     ||  (8)  | 4     |  (1)  ||  (3)  |  (6)  |  (5)  ||
+||       |       | 7     ||       | 7     |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       |       |       ||       |       |       ||
+||     6 |  (1)  | 4     ||     6 |  (5)  | 4     ||  (8)  |  (7)  |  (2)  ||
+||       |       |       ||     9 |       |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |   2   |       ||       |       |       ||
+||     6 |  (5)  |  (8)  ||     6 |       |  (3)  ||  (9)  |  (1)  |  (4)  ||
+|| 7     |       |       ||       | 7     |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||   2 3 |   2 3 |       ||       |       |       ||
+||  (8)  |  (9)  |  (5)  ||       |       |  (7)  ||  (1)  |  (4)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (4)  |  (6)  ||  (1)  |  (9)  |  (5)  ||  (7)  |  (8)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |       ||
+||       |       |  (1)  ||  (4)  |  (8)  |  (6)  ||  (5)  |  (2)  |  (9)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||     3 |       |       ||       |       |       ||
+||  (4)  |       |  (9)  ||       |  (6)  |  (8)  ||  (2)  |  (5)  |  (1)  ||
+||       | 7     |       || 7     |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |       ||       |     3 |       ||
+||  (1)  |  (8)  |  (2)  ||  (5)  | 4     | 4     ||  (6)  |       |  (7)  ||
+||       |       |       ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||       |       |       ||       |     3 |       ||
+||  (5)  |  (6)  |       ||  (3)  |  (1)  |  (2)  ||  (4)  |       |  (8)  ||
+||       |       | 7     ||       |       |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (2)  | 4     ||  (8)  | 4     |  (1)  ||  (3)  |  (6)  |  (5)  ||
+||       |       | 7     ||       | 7     |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       |       |       ||       |       |       ||
+||     6 |  (1)  | 4     ||     6 |  (5)  | 4     ||  (8)  |  (7)  |  (2)  ||
+||       |       |       ||     9 |       |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |   2   |       ||       |       |       ||
+||     6 |  (5)  |  (8)  ||     6 |       |  (3)  ||  (9)  |  (1)  |  (4)  ||
+|| 7     |       |       ||       | 7     |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||   2 3 |   2 3 |       ||       |       |       ||
+||  (8)  |  (9)  |  (5)  ||       |       |  (7)  ||  (1)  |  (4)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (4)  |  (6)  ||  (1)  |  (9)  |  (5)  ||  (7)  |  (8)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |       ||
+||       |       |  (1)  ||  (4)  |  (8)  |  (6)  ||  (5)  |  (2)  |  (9)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := BUGPlus1(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "BUG+1: every other unsolved cell is bivalue, r3c4 must hold 3 to avoid a bivalue universal grave (assuming a unique solution) => r3c4=3")
+}
+
+func TestBUGPlusN(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |   2   |       ||       |       |       ||       |       |   2   ||
+||  (3)  |       |  (5)  ||  (1)  |  (4)  |  (6)  ||  (7)  |       |       ||
+||       |     9 |       ||       |       |       ||       |   8 9 |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   | 1     ||       |   2   |       || 1     |       |       ||
+||  (4)  |       |       ||  (3)  |       |       ||     6 |     6 |  (5)  ||
+||       |     9 |   8   ||       | 7     | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |   2   |       || 1   3 |       |   2 3 ||
+||  (7)  |  (6)  |       ||  (5)  |       |       ||       |  (4)  |       ||
+||       |       |   8   ||       |     9 |   8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  | 4     ||  (9)  |  (8)  |  (1)  || 4   6 |  (5)  |     6 ||
+||       |       | 7     ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |     3 |     3 ||
+||  (6)  |  (1)  |  (9)  ||  (2)  |   5   | 4 5   || 4     |       |       ||
+||       |       |       ||       | 7     | 7     ||       |   8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (8)  | 4     ||  (6)  |  (3)  | 4     ||  (2)  |  (1)  |  (9)  ||
+||       |       | 7     ||       |       | 7     ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||  (1)  |  (7)  |  (2)  ||  (4)  |   5   |   5   ||  (8)  |     6 |     6 ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (5)  |  (6)  ||  (7)  |  (1)  |  (3)  ||  (9)  |  (2)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (4)  |  (3)  ||  (8)  |  (6)  |  (2)  ||  (5)  |  (7)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |   2   |       ||       |       |       ||       |       |   2   ||
+||  (3)  |       |  (5)  ||  (1)  |  (4)  |  (6)  ||  (7)  |       |       ||
+||       |     9 |       ||       |       |       ||       |   8 9 |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   | 1     ||       |   2   |       || 1     |       |       ||
+||  (4)  |       |       ||  (3)  |       |       ||     6 |     6 |  (5)  ||
+||       |     9 |   8   ||       | 7     | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |   2   |       || 1   3 |       |   2 3 ||
+||  (7)  |  (6)  |       ||  (5)  |       |       ||       |  (4)  |       ||
+||       |       |   8   ||       |     9 |   8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  | 4     ||  (9)  |  (8)  |  (1)  || 4   6 |  (5)  |     6 ||
+||       |       | 7     ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |     3 |     3 ||
+||  (6)  |  (1)  |  (9)  ||  (2)  |   5   | 4 5   || 4     |       |       ||
+||       |       |       ||       | 7     | 7     ||       |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (8)  | 4     ||  (6)  |  (3)  | 4     ||  (2)  |  (1)  |  (9)  ||
+||       |       | 7     ||       |       | 7     ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||  (1)  |  (7)  |  (2)  ||  (4)  |   5   |   5   ||  (8)  |     6 |     6 ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (5)  |  (6)  ||  (7)  |  (1)  |  (3)  ||  (9)  |  (2)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (4)  |  (3)  ||  (8)  |  (6)  |  (2)  ||  (5)  |  (7)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := BUGPlusN(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "BUG+n: one of r5c6(7), r5c9(3) holds to avoid a bivalue universal grave (assuming a unique solution) => r5c9<>7")
+}
+
+func TestBUGPlus1TwoTrivalueCells(t *testing.T) {
+	// Both r5c6 and r5c9 are trivalue, so the grave is broken by two candidates,
+	// r5c6(7) and r5c9(3), not by a single one.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |   2   |       ||       |       |       ||       |       |   2   ||
+||  (3)  |       |  (5)  ||  (1)  |  (4)  |  (6)  ||  (7)  |       |       ||
+||       |     9 |       ||       |       |       ||       |   8 9 |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   | 1     ||       |   2   |       || 1     |       |       ||
+||  (4)  |       |       ||  (3)  |       |       ||     6 |     6 |  (5)  ||
+||       |     9 |   8   ||       | 7     | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |   2   |       || 1   3 |       |   2 3 ||
+||  (7)  |  (6)  |       ||  (5)  |       |       ||       |  (4)  |       ||
+||       |       |   8   ||       |     9 |   8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  | 4     ||  (9)  |  (8)  |  (1)  || 4   6 |  (5)  |     6 ||
+||       |       | 7     ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |     3 |     3 ||
+||  (6)  |  (1)  |  (9)  ||  (2)  |   5   | 4 5   || 4     |       |       ||
+||       |       |       ||       | 7     | 7     ||       |   8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (8)  | 4     ||  (6)  |  (3)  | 4     ||  (2)  |  (1)  |  (9)  ||
+||       |       | 7     ||       |       | 7     ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||  (1)  |  (7)  |  (2)  ||  (4)  |   5   |   5   ||  (8)  |     6 |     6 ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (5)  |  (6)  ||  (7)  |  (1)  |  (3)  ||  (9)  |  (2)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (4)  |  (3)  ||  (8)  |  (6)  |  (2)  ||  (5)  |  (7)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := BUGPlus1(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestBUGPlusNNothingToEliminate(t *testing.T) {
+	// One of r6c7(4), r6c8(2), r6c8(9), r6c9(1), r7c8(2), r8c8(9) holds to avoid
+	// a bivalue universal grave, but no candidate sees all of them.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       | 1     |       || 1     |       |       ||
+||  (7)  |  (3)  |  (2)  ||  (8)  |       | 4     || 4     |  (5)  |  (6)  ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (5)  |  (7)  |  (6)  ||  (9)  |  (3)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2   |   2   ||       |       | 1     ||
+||  (6)  |  (9)  |  (5)  ||  (3)  |       | 4     ||  (8)  |  (7)  | 4     ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (7)  |  (9)  ||  (1)  |  (4)  |  (8)  ||  (3)  |  (6)  |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |   2   |       ||
+||  (4)  |  (1)  |  (3)  ||       |  (6)  |  (5)  ||  (7)  |       |  (8)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       || 1 2   | 1 2   | 1     ||
+||  (5)  |  (8)  |  (6)  ||  (7)  |       |  (3)  || 4     | 4     | 4     ||
+||       |       |       ||       |     9 |       ||       |     9 |     9 ||
+##=======================##=======================##=======================##
+||       |       |       ||   2   |       |       ||   2   |   2   |       ||
+||  (1)  |  (6)  |  (8)  ||       |  (5)  |  (7)  || 4     | 4     |  (3)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       | 1 2   | 1     ||
+||  (3)  |  (5)  |  (7)  ||  (4)  |  (8)  |       ||  (6)  |       |       ||
+||       |       |       ||       |       |     9 ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (2)  |  (4)  ||  (6)  |  (3)  |  (1)  ||  (5)  |  (8)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := BUGPlusN(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
@@ -0,0 +1,522 @@
+package strategies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+// chainNode is one or more cells of the same digit acting as a single node.
+// A grouped node holds every candidate of the digit in a box-line intersection.
+type chainNode struct {
+	cells []*sudoku.Cell
+	digit int
+}
+
+// chainLink leads to node. It is strong when at least one of its two ends
+// holds, and weak when at most one does. Links inside a cell have no house.
+type chainLink struct {
+	node   *chainNode
+	house  *sudoku.Set
+	strong bool
+}
+
+type chainGraph struct {
+	nodes      []*chainNode
+	links      map[*chainNode][]chainLink
+	candidates map[candidate]*chainNode
+}
+
+func newChainGraph() *chainGraph {
+	g := chainGraph{
+		[]*chainNode{},
+		make(map[*chainNode][]chainLink),
+		make(map[candidate]*chainNode),
+	}
+
+	return &g
+}
+
+func (n *chainNode) String() string {
+	if len(n.cells) == 1 {
+		return n.cells[0].String()
+	}
+
+	rows := ""
+	columns := ""
+	for _, cell := range n.cells {
+		if !strings.Contains(rows, fmt.Sprint(cell.GetRowId())) {
+			rows += fmt.Sprint(cell.GetRowId())
+		}
+		if !strings.Contains(columns, fmt.Sprint(cell.GetColumnId())) {
+			columns += fmt.Sprint(cell.GetColumnId())
+		}
+	}
+
+	return fmt.Sprintf("r%sc%s", rows, columns)
+}
+
+func (n *chainNode) isInHouse(house *sudoku.Set) bool {
+	for _, cell := range n.cells {
+		if !cellsContain(house.Cells[:], cell) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (n *chainNode) overlaps(other_node *chainNode) bool {
+	if n.digit != other_node.digit {
+		return false
+	}
+
+	for _, cell := range n.cells {
+		if cellsContain(other_node.cells, cell) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSeenBy reports whether the candidate cannot be true together with the
+// node: it shares the node's cell with another digit, or it holds the node's
+// digit in a cell seeing every cell of the node.
+func (n *chainNode) isSeenBy(cell *sudoku.Cell, digit int) bool {
+	if digit != n.digit {
+		return len(n.cells) == 1 && n.cells[0] == cell
+	}
+
+	return !cellsContain(n.cells, cell) && cellSeesAll(cell, n.cells)
+}
+
+func (g *chainGraph) addNode(cells []*sudoku.Cell, digit int) *chainNode {
+	if len(cells) == 1 {
+		if node, found := g.candidates[candidate{cells[0], digit}]; found {
+			return node
+		}
+	}
+
+	node := &chainNode{cells, digit}
+	g.nodes = append(g.nodes, node)
+	if len(cells) == 1 {
+		g.candidates[candidate{cells[0], digit}] = node
+	}
+
+	return node
+}
+
+func (g *chainGraph) addLink(node *chainNode, other_node *chainNode, house *sudoku.Set, strong bool) {
+	for i, link := range g.links[node] {
+		if link.node == other_node {
+			if strong && !link.strong {
+				g.links[node][i] = chainLink{other_node, house, strong}
+			}
+			return
+		}
+	}
+
+	g.links[node] = append(g.links[node], chainLink{other_node, house, strong})
+}
+
+func (g *chainGraph) addDigitNodes(sets [27]*sudoku.Set, digit int, grouped bool) []*chainNode {
+	nodes := []*chainNode{}
+
+	for _, row := range sets[:9] {
+		for _, cell := range getCellsWithPencilMark(row.Cells[:], digit) {
+			nodes = append(nodes, g.addNode([]*sudoku.Cell{cell}, digit))
+		}
+	}
+
+	if !grouped {
+		return nodes
+	}
+
+	for _, box := range sets[18:] {
+		box_cells := getCellsWithPencilMark(box.Cells[:], digit)
+
+		for _, line := range sets[:18] {
+			cells := []*sudoku.Cell{}
+			for _, cell := range box_cells {
+				if cellsContain(line.Cells[:], cell) {
+					cells = append(cells, cell)
+				}
+			}
+
+			if len(cells) >= 2 {
+				nodes = append(nodes, g.addNode(cells, digit))
+			}
+		}
+	}
+
+	return nodes
+}
+
+// addHouseLinks links every two disjoint nodes of the digit sharing a house.
+// The link is strong when the two nodes hold every candidate of the digit in
+// that house.
+func (g *chainGraph) addHouseLinks(sets [27]*sudoku.Set, digit int, grouped bool) {
+	nodes := g.addDigitNodes(sets, digit, grouped)
+
+	for _, house := range sets {
+		house_cell_count := len(getCellsWithPencilMark(house.Cells[:], digit))
+
+		house_nodes := []*chainNode{}
+		for _, node := range nodes {
+			if node.isInHouse(house) {
+				house_nodes = append(house_nodes, node)
+			}
+		}
+
+		for _, combination := range getCombinations(len(house_nodes), 2) {
+			node := house_nodes[combination[0]]
+			other_node := house_nodes[combination[1]]
+			if node.overlaps(other_node) {
+				continue
+			}
+
+			strong := len(node.cells)+len(other_node.cells) == house_cell_count
+			g.addLink(node, other_node, house, strong)
+			g.addLink(other_node, node, house, strong)
+		}
+	}
+}
+
+// addCellLinks weakly links the candidates of every cell, strongly when the
+// cell is bivalue.
+func (g *chainGraph) addCellLinks(cells []*sudoku.Cell) {
+	for _, cell := range cells {
+		if cell.GetValue() != sudoku.Empty {
+			continue
+		}
+
+		pencil_marks := cell.GetPencilMarks()
+		strong := len(pencil_marks) == 2
+
+		for _, combination := range getCombinations(len(pencil_marks), 2) {
+			node := g.addNode([]*sudoku.Cell{cell}, pencil_marks[combination[0]])
+			other_node := g.addNode([]*sudoku.Cell{cell}, pencil_marks[combination[1]])
+			g.addLink(node, other_node, nil, strong)
+			g.addLink(other_node, node, nil, strong)
+		}
+	}
+}
+
+// addPeerLinks weakly links the same digits of every two cells seeing each
+// other. Unlike addHouseLinks it never adds strong links, so the only strong
+// links of a graph built from bivalue cells are the ones inside the cells.
+func (g *chainGraph) addPeerLinks(sets [27]*sudoku.Set, cells []*sudoku.Cell) {
+	for _, combination := range getCombinations(len(cells), 2) {
+		cell := cells[combination[0]]
+		other_cell := cells[combination[1]]
+		if !cellsSeeEachOther(cell, other_cell) {
+			continue
+		}
+
+		house := getSharedHouses(sets, cell, other_cell)[0]
+		for _, digit := range getDigitsFromMask(getPencilMarkMask(cell) & getPencilMarkMask(other_cell)) {
+			node := g.addNode([]*sudoku.Cell{cell}, digit)
+			other_node := g.addNode([]*sudoku.Cell{other_cell}, digit)
+			g.addLink(node, other_node, house, false)
+			g.addLink(other_node, node, house, false)
+		}
+	}
+}
+
+// chainPath is an alternating chain of nodes. links[i] connects nodes[i] to
+// nodes[i+1] and strong[i] tells whether it is used as a strong link. The
+// last link of a loop leads back to nodes[0].
+type chainPath struct {
+	nodes  []*chainNode
+	links  []chainLink
+	strong []bool
+}
+
+func (p *chainPath) format(with_digits bool) string {
+	format_node := func(node *chainNode) string {
+		if with_digits {
+			return fmt.Sprintf("(%d)%s", node.digit, node)
+		}
+		return node.String()
+	}
+
+	path_string := format_node(p.nodes[0])
+	for i, link := range p.links {
+		if p.strong[i] {
+			path_string += "="
+		} else {
+			path_string += "-"
+		}
+		path_string += format_node(link.node)
+	}
+
+	return path_string
+}
+
+// formatEureka writes the chain in Eureka notation, merging the nodes linked
+// inside a cell, like (1=2)r1c1-(2)r1c5=(2-3)r5c5.
+func (p *chainPath) formatEureka() string {
+	link_string := func(i int) string {
+		if p.strong[i] {
+			return "="
+		}
+		return "-"
+	}
+
+	path_string := ""
+	for i := 0; i < len(p.nodes); i++ {
+		node := p.nodes[i]
+		digits := fmt.Sprint(node.digit)
+		for i < len(p.links) && p.links[i].house == nil {
+			digits += link_string(i) + fmt.Sprint(p.links[i].node.digit)
+			i++
+		}
+
+		path_string += fmt.Sprintf("(%s)%s", digits, node)
+		if i < len(p.links) {
+			path_string += link_string(i)
+		}
+	}
+
+	return path_string
+}
+
+func (p *chainPath) getCells() []*sudoku.Cell {
+	cells := []*sudoku.Cell{}
+	for _, node := range p.nodes {
+		for _, cell := range node.cells {
+			if !cellsContain(cells, cell) {
+				cells = append(cells, cell)
+			}
+		}
+	}
+
+	return cells
+}
+
+func (p *chainPath) newStep(technique string) *Step {
+	step := newStep(technique)
+	step.Cells = p.getCells()
+
+	for _, link := range p.links {
+		if link.house != nil && !setsContain(step.Houses, link.house) {
+			step.Houses = append(step.Houses, link.house)
+		}
+	}
+
+	return step
+}
+
+func setsContain(sets []*sudoku.Set, set *sudoku.Set) bool {
+	for _, other_set := range sets {
+		if other_set == set {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findLoops walks every alternating chain of exactly length links that
+// returns to its first node and hands it to found, stopping once found
+// returns true. Strong links may also be used where a weak one is expected.
+func (g *chainGraph) findLoops(length int, found func(loop *chainPath) bool) bool {
+	var extend func(loop *chainPath) bool
+	extend = func(loop *chainPath) bool {
+		current := loop.nodes[len(loop.nodes)-1]
+		strong := (len(loop.links)%2 == 0) == loop.strong[0]
+
+		for _, link := range g.links[current] {
+			if strong && !link.strong {
+				continue
+			}
+
+			if len(loop.links)+1 == length {
+				if link.node != loop.nodes[0] {
+					continue
+				}
+
+				loop.links = append(loop.links, link)
+				loop.strong = append(loop.strong, strong)
+				done := found(loop)
+				loop.links = loop.links[:len(loop.links)-1]
+				loop.strong = loop.strong[:len(loop.strong)-1]
+
+				if done {
+					return true
+				}
+				continue
+			}
+
+			overlaps := false
+			for _, node := range loop.nodes {
+				if node.overlaps(link.node) {
+					overlaps = true
+					break
+				}
+			}
+			if overlaps {
+				continue
+			}
+
+			loop.nodes = append(loop.nodes, link.node)
+			loop.links = append(loop.links, link)
+			loop.strong = append(loop.strong, strong)
+			done := extend(loop)
+			loop.nodes = loop.nodes[:len(loop.nodes)-1]
+			loop.links = loop.links[:len(loop.links)-1]
+			loop.strong = loop.strong[:len(loop.strong)-1]
+
+			if done {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, start := range g.nodes {
+		for _, first_strong := range []bool{true, false} {
+			loop := &chainPath{[]*chainNode{start}, []chainLink{}, []bool{}}
+
+			for _, link := range g.links[start] {
+				if first_strong && !link.strong {
+					continue
+				}
+
+				loop.nodes = append(loop.nodes, link.node)
+				loop.links = append(loop.links, link)
+				loop.strong = append(loop.strong, first_strong)
+				done := extend(loop)
+				loop.nodes = loop.nodes[:1]
+				loop.links = loop.links[:0]
+				loop.strong = loop.strong[:0]
+
+				if done {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+type chainState struct {
+	node *chainNode
+	on   bool
+}
+
+type chainStep struct {
+	state chainState
+	link  chainLink
+}
+
+// chainSearch holds every state reachable from start being off, layer by
+// layer. Leaving an off node takes a strong link and turns the next one on,
+// leaving an on node takes any link and turns the next one off. Each state
+// keeps the first path reaching it that does not use a candidate twice.
+type chainSearch struct {
+	start   *chainNode
+	layers  [][]chainState
+	parents map[chainState]chainStep
+}
+
+func newChainSearch(start *chainNode) *chainSearch {
+	start_state := chainState{start, false}
+	s := chainSearch{
+		start,
+		[][]chainState{{start_state}},
+		map[chainState]chainStep{start_state: {}},
+	}
+
+	return &s
+}
+
+func (s *chainSearch) isOnPath(state chainState, node *chainNode) bool {
+	for {
+		if state.node.overlaps(node) {
+			return true
+		}
+
+		if state.node == s.start && !state.on {
+			return false
+		}
+
+		state = s.parents[state].state
+	}
+}
+
+func (s *chainSearch) addLayer(g *chainGraph) {
+	layer := []chainState{}
+
+	for _, state := range s.layers[len(s.layers)-1] {
+		for _, link := range g.links[state.node] {
+			if !state.on && !link.strong {
+				continue
+			}
+
+			next_state := chainState{link.node, !state.on}
+			if _, found := s.parents[next_state]; found || s.isOnPath(state, link.node) {
+				continue
+			}
+
+			s.parents[next_state] = chainStep{state, link}
+			layer = append(layer, next_state)
+		}
+	}
+
+	s.layers = append(s.layers, layer)
+}
+
+// getChain rebuilds the chain of length links leading to state.
+func (s *chainSearch) getChain(state chainState, length int) *chainPath {
+	chain := &chainPath{make([]*chainNode, length+1), make([]chainLink, length), make([]bool, length)}
+
+	for i := length; i > 0; i-- {
+		chain_step := s.parents[state]
+		chain.nodes[i] = state.node
+		chain.links[i-1] = chain_step.link
+		chain.strong[i-1] = state.on
+		state = chain_step.state
+	}
+	chain.nodes[0] = s.start
+
+	return chain
+}
+
+// findChains hands every alternating chain that starts and ends with a strong
+// link to found, shortest first, stopping once found returns true. At least
+// one end of such a chain holds.
+func (g *chainGraph) findChains(min_length int, max_length int, found func(chain *chainPath) bool) bool {
+	searches := []*chainSearch{}
+	for _, start := range g.nodes {
+		searches = append(searches, newChainSearch(start))
+	}
+
+	for length := 1; length <= max_length; length += 2 {
+		for _, s := range searches {
+			for len(s.layers) <= length {
+				s.addLayer(g)
+			}
+
+			if length < min_length {
+				continue
+			}
+
+			for _, state := range s.layers[length] {
+				if !state.on {
+					continue
+				}
+
+				if found(s.getChain(state, length)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,166 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func getChainLink(g *chainGraph, node *chainNode, other_node *chainNode) *chainLink {
+	for _, link := range g.links[node] {
+		if link.node == other_node {
+			return &link
+		}
+	}
+
+	return nil
+}
+
+func TestChainGraphHouseLinks(t *testing.T) {
+	grid := sudoku.NewGrid()
+	sets := grid.GetSets()
+	cells := grid.GetAllCells()
+
+	for _, cell := range sets[0].Cells {
+		if cell != cells[0] && cell != cells[4] {
+			AssertNoError(t, cell.RemovePencilMark(1))
+		}
+	}
+
+	graph := newChainGraph()
+	graph.addHouseLinks(sets, 1, false)
+
+	r1c1 := graph.candidates[candidate{cells[0], 1}]
+	r1c5 := graph.candidates[candidate{cells[4], 1}]
+	r2c1 := graph.candidates[candidate{cells[9], 1}]
+
+	if link := getChainLink(graph, r1c1, r1c5); link == nil || !link.strong || link.house != sets[0] {
+		t.Errorf("r1c1 and r1c5 should be strongly linked in row 1")
+	}
+
+	if link := getChainLink(graph, r1c1, r2c1); link == nil || link.strong {
+		t.Errorf("r1c1 and r2c1 should be weakly linked")
+	}
+
+	if link := getChainLink(graph, r1c5, r2c1); link != nil {
+		t.Errorf("r1c5 and r2c1 do not share a house")
+	}
+}
+
+func TestChainGraphPeerLinks(t *testing.T) {
+	grid := sudoku.NewGrid()
+	cells := grid.GetAllCells()
+	bivalue_cells := []*sudoku.Cell{cells[0], cells[1], cells[40]}
+
+	for _, cell := range bivalue_cells {
+		AssertNoError(t, cell.RemovePencilMarks([]int{3, 4, 5, 6, 7, 8, 9}))
+	}
+
+	graph := newChainGraph()
+	graph.addCellLinks(bivalue_cells)
+	graph.addPeerLinks(grid.GetSets(), bivalue_cells)
+
+	r1c1_1 := graph.candidates[candidate{cells[0], 1}]
+	r1c1_2 := graph.candidates[candidate{cells[0], 2}]
+	r1c2_1 := graph.candidates[candidate{cells[1], 1}]
+
+	if link := getChainLink(graph, r1c1_1, r1c1_2); link == nil || !link.strong {
+		t.Errorf("the candidates of a bivalue cell should be strongly linked")
+	}
+
+	if link := getChainLink(graph, r1c1_1, r1c2_1); link == nil || link.strong {
+		t.Errorf("peer links should be weak even for a conjugate pair")
+	}
+
+	if len(graph.links[graph.candidates[candidate{cells[40], 1}]]) != 1 {
+		t.Errorf("r5c5 sees no other bivalue cell")
+	}
+}
+
+// The shortest way to turn r1c4 off goes through r1c1 again, which must not
+// hide the longer chain reaching it.
+func TestFindChainsSkipsRevisitedNodes(t *testing.T) {
+	grid := sudoku.NewGrid()
+	cells := grid.GetAllCells()
+	graph := newChainGraph()
+
+	nodes := map[string]*chainNode{}
+	for i, name := range []string{"S", "A", "B", "C", "D", "H", "I"} {
+		nodes[name] = graph.addNode([]*sudoku.Cell{cells[i]}, 1)
+	}
+
+	for _, link := range []struct {
+		node       string
+		other_node string
+		strong     bool
+	}{
+		{"S", "A", true},
+		{"S", "B", true},
+		{"S", "C", false},
+		{"A", "B", false},
+		{"A", "H", false},
+		{"H", "I", true},
+		{"I", "C", false},
+		{"C", "D", true},
+	} {
+		graph.addLink(nodes[link.node], nodes[link.other_node], nil, link.strong)
+		graph.addLink(nodes[link.other_node], nodes[link.node], nil, link.strong)
+	}
+
+	chains := []string{}
+	graph.findChains(5, 5, func(chain *chainPath) bool {
+		if chain.nodes[0] == nodes["S"] && chain.nodes[5] == nodes["D"] {
+			chains = append(chains, chain.format(false))
+		}
+		return false
+	})
+
+	if len(chains) != 1 || chains[0] != "r1c1=r1c2-r1c6=r1c7-r1c4=r1c5" {
+		t.Errorf("unexpected chains: %v", chains)
+	}
+}
+
+func TestFindLoops(t *testing.T) {
+	grid := sudoku.NewGrid()
+	sets := grid.GetSets()
+	cells := grid.GetAllCells()
+	corners := []*sudoku.Cell{cells[0], cells[4], cells[36], cells[40]}
+
+	for _, cell := range cells {
+		if !cellsContain(corners, cell) {
+			AssertNoError(t, cell.RemovePencilMark(1))
+		}
+	}
+
+	loops := []string{}
+	getXCycleGraph(sets, 1, false).findLoops(4, func(loop *chainPath) bool {
+		if loop.nodes[0].cells[0] == cells[0] && loop.strong[0] {
+			loops = append(loops, loop.format(false))
+		}
+		return false
+	})
+
+	if len(loops) != 2 || loops[0] != "r1c1=r1c5-r5c5=r5c1-r1c1" || loops[1] != "r1c1=r5c1-r5c5=r1c5-r1c1" {
+		t.Errorf("unexpected loops: %v", loops)
+	}
+}
+
+func TestChainPathFormatEureka(t *testing.T) {
+	grid := sudoku.NewGrid()
+	cells := grid.GetAllCells()
+	sets := grid.GetSets()
+
+	chain := &chainPath{
+		[]*chainNode{{cells[:1], 1}, {cells[:1], 2}, {cells[4:5], 2}, {cells[40:41], 2}, {cells[40:41], 3}, {cells[44:45], 3}},
+		[]chainLink{{nil, nil, true}, {nil, sets[0], false}, {nil, sets[13], true}, {nil, nil, false}, {nil, sets[4], true}},
+		[]bool{true, false, true, false, true},
+	}
+	for i := range chain.links {
+		chain.links[i].node = chain.nodes[i+1]
+	}
+
+	if chain.formatEureka() != "(1=2)r1c1-(2)r1c5=(2-3)r5c5=(3)r5c9" {
+		t.Errorf("unexpected chain: %s", chain.formatEureka())
+	}
+}
@@ -0,0 +1,267 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+type colourCluster struct {
+	digit   int
+	colours map[*sudoku.Cell]int
+	cells   [2][]*sudoku.Cell
+}
+
+func getConjugatePairs(sets [27]*sudoku.Set, digit int) [][2]*sudoku.Cell {
+	conjugate_pairs := [][2]*sudoku.Cell{}
+
+	for _, set := range sets {
+		cells := getCellsWithPencilMark(set.Cells[:], digit)
+		if len(cells) != 2 {
+			continue
+		}
+
+		duplicate := false
+		for _, conjugate_pair := range conjugate_pairs {
+			if conjugate_pair[0] == cells[0] && conjugate_pair[1] == cells[1] {
+				duplicate = true
+				break
+			}
+		}
+
+		if !duplicate {
+			conjugate_pairs = append(conjugate_pairs, [2]*sudoku.Cell{cells[0], cells[1]})
+		}
+	}
+
+	return conjugate_pairs
+}
+
+// getColourClusters 2-colours every connected group of conjugate pairs, so
+// that exactly one of the two colours holds the digit in each cluster.
+func getColourClusters(sets [27]*sudoku.Set, digit int) []*colourCluster {
+	links := map[*sudoku.Cell][]*sudoku.Cell{}
+	cells := []*sudoku.Cell{}
+
+	for _, conjugate_pair := range getConjugatePairs(sets, digit) {
+		for i, cell := range conjugate_pair {
+			if _, found := links[cell]; !found {
+				cells = append(cells, cell)
+			}
+			links[cell] = append(links[cell], conjugate_pair[1-i])
+		}
+	}
+
+	sortCells(cells)
+
+	clusters := []*colourCluster{}
+	coloured := map[*sudoku.Cell]bool{}
+
+	for _, start := range cells {
+		if coloured[start] {
+			continue
+		}
+
+		cluster := &colourCluster{digit, map[*sudoku.Cell]int{start: 0}, [2][]*sudoku.Cell{}}
+		coloured[start] = true
+		queue := []*sudoku.Cell{start}
+
+		for len(queue) > 0 {
+			cell := queue[0]
+			queue = queue[1:]
+
+			for _, linked_cell := range links[cell] {
+				if coloured[linked_cell] {
+					continue
+				}
+
+				coloured[linked_cell] = true
+				cluster.colours[linked_cell] = 1 - cluster.colours[cell]
+				queue = append(queue, linked_cell)
+			}
+		}
+
+		for cell, colour := range cluster.colours {
+			cluster.cells[colour] = append(cluster.cells[colour], cell)
+		}
+		sortCells(cluster.cells[0])
+		sortCells(cluster.cells[1])
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters
+}
+
+func (c *colourCluster) String() string {
+	return fmt.Sprintf("%d coloured A: %s, B: %s", c.digit, formatCells(c.cells[0]), formatCells(c.cells[1]))
+}
+
+func (c *colourCluster) hasColourSeeingItself(colour int) bool {
+	for _, combination := range getCombinations(len(c.cells[colour]), 2) {
+		if cellsSeeEachOther(c.cells[colour][combination[0]], c.cells[colour][combination[1]]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func cellSeesAny(cell *sudoku.Cell, other_cells []*sudoku.Cell) bool {
+	for _, other_cell := range other_cells {
+		if cellsSeeEachOther(cell, other_cell) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findColourWrap(cluster *colourCluster) *Step {
+	for colour, name := range []string{"A", "B"} {
+		if !cluster.hasColourSeeingItself(colour) {
+			continue
+		}
+
+		step := newStep("Simple Colouring")
+		step.Description = fmt.Sprintf("%s; colour wrap, two cells coloured %s see each other", cluster, name)
+		step.Cells = append(step.Cells, cluster.cells[0]...)
+		step.Cells = append(step.Cells, cluster.cells[1]...)
+
+		for _, cell := range cluster.cells[colour] {
+			step.eliminate(cell, cluster.digit)
+		}
+
+		return step
+	}
+
+	return nil
+}
+
+func findColourTrap(grid *sudoku.Grid, cluster *colourCluster) *Step {
+	step := newStep("Simple Colouring")
+	step.Description = fmt.Sprintf("%s; colour trap, cells seeing both colours cannot hold %d", cluster, cluster.digit)
+	step.Cells = append(step.Cells, cluster.cells[0]...)
+	step.Cells = append(step.Cells, cluster.cells[1]...)
+
+	for _, cell := range grid.GetAllCells() {
+		if _, found := cluster.colours[cell]; found {
+			continue
+		}
+
+		if cellSeesAny(cell, cluster.cells[0]) && cellSeesAny(cell, cluster.cells[1]) {
+			step.eliminate(cell, cluster.digit)
+		}
+	}
+
+	if !step.hasChanges() {
+		return nil
+	}
+
+	return step
+}
+
+func SimpleColouring(grid *sudoku.Grid) (*Step, error) {
+	sets := grid.GetSets()
+
+	for digit := 1; digit <= 9; digit++ {
+		for _, cluster := range getColourClusters(sets, digit) {
+			if step := findColourWrap(cluster); step != nil {
+				return step, nil
+			}
+
+			if step := findColourTrap(grid, cluster); step != nil {
+				return step, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func colourSeesColour(cells []*sudoku.Cell, other_cells []*sudoku.Cell) bool {
+	for _, cell := range cells {
+		if cellSeesAny(cell, other_cells) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findMultiColouring checks two clusters of the same digit. When a colour of
+// one cluster sees a colour of the other, the two cannot both be true, so one
+// of their opposite colours is.
+func findMultiColouring(grid *sudoku.Grid, cluster *colourCluster, other_cluster *colourCluster) *Step {
+	names := [2]string{"A", "B"}
+	other_names := [2]string{"C", "D"}
+	description := fmt.Sprintf("%d coloured A: %s, B: %s, C: %s, D: %s", cluster.digit, formatCells(cluster.cells[0]), formatCells(cluster.cells[1]), formatCells(other_cluster.cells[0]), formatCells(other_cluster.cells[1]))
+
+	for colour := 0; colour < 2; colour++ {
+		for other_colour := 0; other_colour < 2; other_colour++ {
+			if !colourSeesColour(cluster.cells[colour], other_cluster.cells[other_colour]) {
+				continue
+			}
+
+			opposite_cells := cluster.cells[1-colour]
+			other_opposite_cells := other_cluster.cells[1-other_colour]
+
+			step := newStep("Multi-Colouring")
+			step.Cells = append(step.Cells, cluster.cells[0]...)
+			step.Cells = append(step.Cells, cluster.cells[1]...)
+			step.Cells = append(step.Cells, other_cluster.cells[0]...)
+			step.Cells = append(step.Cells, other_cluster.cells[1]...)
+
+			if colourSeesColour(cluster.cells[colour], other_opposite_cells) {
+				step.Description = fmt.Sprintf("%s; %s sees both %s and %s, so it cannot hold %d", description, names[colour], other_names[other_colour], other_names[1-other_colour], cluster.digit)
+
+				for _, cell := range cluster.cells[colour] {
+					step.eliminate(cell, cluster.digit)
+				}
+
+				return step
+			}
+
+			step.Description = fmt.Sprintf("%s; %s sees %s, so either %s or %s holds %d", description, names[colour], other_names[other_colour], names[1-colour], other_names[1-other_colour], cluster.digit)
+
+			for _, cell := range grid.GetAllCells() {
+				_, in_cluster := cluster.colours[cell]
+				_, in_other_cluster := other_cluster.colours[cell]
+				if in_cluster || in_other_cluster {
+					continue
+				}
+
+				if cellSeesAny(cell, opposite_cells) && cellSeesAny(cell, other_opposite_cells) {
+					step.eliminate(cell, cluster.digit)
+				}
+			}
+
+			if step.hasChanges() {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
+
+func MultiColouring(grid *sudoku.Grid) (*Step, error) {
+	sets := grid.GetSets()
+
+	for digit := 1; digit <= 9; digit++ {
+		clusters := getColourClusters(sets, digit)
+
+		for _, combination := range getCombinations(len(clusters), 2) {
+			for _, order := range [][2]int{{0, 1}, {1, 0}} {
+				cluster := clusters[combination[order[0]]]
+				other_cluster := clusters[combination[order[1]]]
+
+				if step := findMultiColouring(grid, cluster, other_cluster); step != nil {
+					return step, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
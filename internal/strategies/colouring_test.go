@@ -0,0 +1,387 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestSimpleColouringTrap(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   | 1     ||       |       |   2   ||
+||  (3)  |  (9)  |   5   ||   5   |       |   5   ||  (6)  |  (4)  |       ||
+||       |       | 7     || 7 8   |   8   | 7 8   ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |   2   ||       |       |       ||       |       |       ||
+||       |  (8)  |     6 || 4   6 |  (9)  | 4   6 ||  (3)  |  (5)  |  (1)  ||
+|| 7     |       |       || 7     |       | 7     ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       ||       |       |   2   ||
+||  (4)  |   5 6 |  (1)  ||  (3)  |     6 |   5 6 ||  (9)  |  (8)  |       ||
+||       | 7     |       ||       |       |       ||       |       | 7     ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (3)  | 4 5   ||  (2)  |  (7)  | 4 5   || 4 5   |  (6)  |  (9)  ||
+||       |       |   8   ||       |       |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |     3 ||       |     3 |       ||
+||   5   | 4 5   | 4 5   || 4 5 6 |  (1)  | 4 5 6 ||  (2)  |       |  (8)  ||
+|| 7   9 | 7     | 7   9 ||       |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |     3 ||       |       |     3 ||
+||  (6)  |  (2)  | 4 5   ||  (9)  | 4     | 4 5   || 4 5   |  (1)  | 4 5   ||
+||       |       | 7 8   ||       |   8   |   8   || 7     |       |       ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |       |       || 1     |       |       ||
+||  (8)  | 4     |  (3)  || 4     |  (5)  |  (9)  || 4     |  (2)  |  (6)  ||
+||       | 7     |       || 7     |       |       || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||   5   |  (1)  | 4 5   || 4   6 | 4   6 |  (2)  ||   5   |       | 4 5   ||
+|| 7   9 |       | 7   9 || 7 8   |   8   |       || 7 8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |   2   || 1     |       | 1     || 1     |       |       ||
+||   5   | 4 5 6 |     6 || 4     |  (3)  | 4     || 4 5   |  (9)  | 4 5   ||
+|| 7     | 7     |       || 7 8   |       | 7 8   || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   | 1     ||       |       |   2   ||
+||  (3)  |  (9)  |   5   ||   5   |       |   5   ||  (6)  |  (4)  |       ||
+||       |       | 7     || 7 8   |   8   | 7 8   ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |   2   ||       |       |       ||       |       |       ||
+||       |  (8)  |     6 || 4   6 |  (9)  | 4   6 ||  (3)  |  (5)  |  (1)  ||
+|| 7     |       |       || 7     |       | 7     ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       ||       |       |   2   ||
+||  (4)  |   5 6 |  (1)  ||  (3)  |     6 |   5 6 ||  (9)  |  (8)  |       ||
+||       | 7     |       ||       |       |       ||       |       | 7     ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (3)  | 4 5   ||  (2)  |  (7)  | 4 5   || 4 5   |  (6)  |  (9)  ||
+||       |       |   8   ||       |       |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |     3 ||       |     3 |       ||
+||   5   | 4 5   | 4 5   || 4 5 6 |  (1)  | 4 5 6 ||  (2)  |       |  (8)  ||
+|| 7   9 | 7     | 7   9 ||       |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |     3 ||       |       |     3 ||
+||  (6)  |  (2)  | 4 5   ||  (9)  | 4     | 4 5   || 4 5   |  (1)  | 4 5   ||
+||       |       | 7 8   ||       |   8   |   8   || 7     |       |       ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |       |       || 1     |       |       ||
+||  (8)  | 4     |  (3)  || 4     |  (5)  |  (9)  || 4     |  (2)  |  (6)  ||
+||       | 7     |       || 7     |       |       || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||   5   |  (1)  | 4 5   || 4   6 | 4   6 |  (2)  ||   5   |       | 4 5   ||
+|| 7   9 |       |     9 || 7 8   |   8   |       || 7 8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |   2   || 1     |       | 1     || 1     |       |       ||
+||   5   | 4 5 6 |     6 || 4     |  (3)  | 4     || 4 5   |  (9)  | 4 5   ||
+|| 7     | 7     |       || 7 8   |       | 7 8   || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := SimpleColouring(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Simple Colouring: 7 coloured A: r5c8, r6c3, B: r6c7, r8c8; colour trap, cells seeing both colours cannot hold 7 => r8c3<>7")
+}
+
+func TestSimpleColouringWrap(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |  (5)  | 4   6 || 4   6 |  (8)  |  (9)  ||  (2)  |     6 |  (1)  ||
+||       |       |       || 7     |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  | 4   6 ||  (2)  |  (1)  |   5 6 ||  (3)  |  (8)  | 4 5   ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (8)  |  (2)  || 4 5 6 | 4   6 |  (3)  ||  (9)  |     6 | 4 5   ||
+||       |       |       || 7     | 7     |       ||       | 7     |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |     6 |  (1)  ||  (3)  | 4   6 |  (8)  || 4   6 |  (5)  |  (2)  ||
+||       | 7     |       ||       | 7     |       || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |     6 |  (3)  || 4 5 6 |  (2)  |   5   || 4   6 |  (1)  |  (9)  ||
+||       | 7     |       || 7     |       | 7     || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (4)  |  (2)  |  (5)  ||     6 |  (9)  |  (1)  ||  (8)  |  (3)  |     6 ||
+||       |       |       || 7     |       |       ||       |       | 7     ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (1)  |  (7)  ||  (8)  |   5 6 |  (4)  ||   5 6 |  (9)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (4)  |  (8)  ||  (9)  |  (3)  |     6 ||  (1)  |  (2)  |     6 ||
+||       |       |       ||       |       | 7     ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (6)  |  (3)  |  (9)  ||  (1)  |   5   |  (2)  ||   5   |  (4)  |  (8)  ||
+||       |       |       ||       | 7     |       || 7     |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |  (5)  | 4   6 || 4   6 |  (8)  |  (9)  ||  (2)  |     6 |  (1)  ||
+||       |       |       || 7     |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  | 4   6 ||  (2)  |  (1)  |   5 6 ||  (3)  |  (8)  | 4 5   ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (8)  |  (2)  || 4 5 6 | 4   6 |  (3)  ||  (9)  |     6 | 4 5   ||
+||       |       |       || 7     | 7     |       ||       | 7     |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |     6 |  (1)  ||  (3)  | 4   6 |  (8)  || 4   6 |  (5)  |  (2)  ||
+||       | 7     |       ||       | 7     |       || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |     6 |  (3)  || 4 5 6 |  (2)  |   5   || 4   6 |  (1)  |  (9)  ||
+||       | 7     |       || 7     |       |       || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (4)  |  (2)  |  (5)  ||     6 |  (9)  |  (1)  ||  (8)  |  (3)  |     6 ||
+||       |       |       ||       |       |       ||       |       | 7     ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (1)  |  (7)  ||  (8)  |   5 6 |  (4)  ||   5 6 |  (9)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (4)  |  (8)  ||  (9)  |  (3)  |     6 ||  (1)  |  (2)  |     6 ||
+||       |       |       ||       |       | 7     ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (6)  |  (3)  |  (9)  ||  (1)  |   5   |  (2)  ||   5   |  (4)  |  (8)  ||
+||       |       |       ||       |       |       || 7     |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := SimpleColouring(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Simple Colouring: 7 coloured A: r5c6, r6c4, r8c9, r9c5, B: r6c9, r8c6, r9c7; colour wrap, two cells coloured A see each other => r5c6<>7, r6c4<>7, r8c9<>7, r9c5<>7")
+}
+
+func TestSimpleColouringNothingToEliminate(t *testing.T) {
+	// The conjugate pairs of 9 colour A: r1c7, r4c8, r6c3, r7c2, r8c9 and B:
+	// r1c9, r4c2, r6c7, r7c3, r8c8, but no colour sees itself and no cell seeing
+	// both colours holds 9.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       | 1   3 |     3 ||       |       |     3 || 1     |       |       ||
+||  (7)  |   5 6 |   5 6 ||  (4)  |  (2)  |   5 6 ||   5   |  (8)  |   5   ||
+||       |       |       ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |       |     3 ||       |       |       ||
+||   5   |  (8)  |  (4)  ||  (9)  |  (1)  |   5   ||  (7)  |  (6)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       |       || 1     |       |       ||
+||  (9)  |   5 6 |  (2)  ||   5 6 |   5 6 |  (7)  ||   5   |  (3)  |  (4)  ||
+||       |       |       ||   8   |   8   |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+|| 4   6 |   5   |  (1)  ||  (7)  |   5 6 |   5 6 ||  (2)  | 4     |  (3)  ||
+||       |     9 |       ||       |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |     3 ||       |       |       ||       |       |       ||
+||  (8)  |       |       ||  (2)  |  (4)  |  (9)  ||  (6)  |  (5)  |  (1)  ||
+||       | 7     | 7     ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+|| 4   6 |  (2)  |   5   ||  (3)  |   5 6 |  (1)  || 4     |  (7)  |  (8)  ||
+||       |       |     9 ||       |       |       ||     9 |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |     6 |     6 ||   5   |  (3)  | 4 5   || 4 5   |  (1)  |  (7)  ||
+||       |     9 |     9 ||   8   |       |   8   ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |   2   |       ||
+||  (1)  |  (4)  |  (8)  ||   5 6 |  (7)  |   5 6 ||  (3)  |       |   5   ||
+||       |       |       ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |     3 ||       |       |   2   ||       |   2   |       ||
+||   5   |   5   |   5   ||  (1)  |  (9)  | 4     || 4     | 4     |  (6)  ||
+||       | 7     | 7     ||       |       |   8   ||   8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := SimpleColouring(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestMultiColouring(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1 2 3 ||       |       | 1 2 3 ||   2   |   2   | 1 2   ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+|| 7 8   |       | 7 8   ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2 3 | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1 2 3 ||       |       | 1 2 3 ||   2   |   2   | 1     ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+|| 7 8   |       | 7 8   ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2 3 | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := MultiColouring(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Multi-Colouring: 2 coloured A: r5c6, B: r6c4, r8c6, C: r9c4, D: r9c9; B sees C, so either A or D holds 2 => r5c9<>2")
+}
+
+func TestMultiColouringNothingToEliminate(t *testing.T) {
+	// 6 is coloured A: r1c7, r5c5, r6c8, B: r2c8, r5c7, r6c5 in one cluster and
+	// C: r2c3, r3c4, D: r3c3 in another. B sees C, so A or D holds 6, but no
+	// cell seeing both A and D holds 6.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||       |     3 |     3 ||       |       |       ||
+||  (2)  |       |  (7)  || 4 5 6 | 4 5   | 4   6 ||     6 |  (1)  |       ||
+||       |     9 |       ||       |     9 |     9 ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     | 1     ||       |       | 1     ||       |       |       ||
+||  (8)  |   5   |   5 6 ||  (7)  |  (2)  |     6 ||  (4)  |     6 |  (3)  ||
+||       |     9 |     9 ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     || 1     | 1   3 |       ||       |       |       ||
+||       |  (4)  |     6 ||     6 |       |  (8)  ||  (2)  |  (5)  |  (7)  ||
+||       |       |     9 ||       |     9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     | 1     ||       |       |       ||
+||  (6)  |       |  (3)  ||  (2)  | 4     | 4     ||       |       |  (5)  ||
+||       |   8 9 |       ||       |     9 |     9 || 7 8 9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   ||       | 1     |       ||       |       |       ||
+||   5   |   5   |   5   ||  (8)  |     6 |  (7)  ||     6 |  (3)  |  (4)  ||
+||       |     9 |     9 ||       |     9 |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |   2   |   2   ||
+||  (7)  |       |  (4)  ||  (3)  |     6 |  (5)  ||  (1)  |     6 |       ||
+||       |   8 9 |       ||       |     9 |       ||       |     9 |   8 9 ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2 3 | 1 2   || 1     |       | 1   3 ||       |       | 1     ||
+|| 4 5   |   5   |   5   || 4 5 6 |  (8)  | 4   6 ||       | 4     |       ||
+||       |       |       ||       |       |       || 7   9 | 7   9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       | 1   3 ||     3 |   2   | 1 2   ||
+||  (9)  |  (6)  |  (8)  || 4 5   |  (7)  | 4     ||   5   | 4     |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     ||       | 1   3 |       ||     3 |       |       ||
+|| 4 5   |  (7)  |   5   ||  (9)  | 4 5   |  (2)  ||   5   |  (8)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := MultiColouring(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
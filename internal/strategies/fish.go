@@ -0,0 +1,303 @@
+package strategies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+var fish_names = map[int]string{
+	2: "X-Wing",
+	3: "Swordfish",
+	4: "Jellyfish",
+}
+
+func getCrossingLineIndex(cell *sudoku.Cell, orientation string) int {
+	if orientation == "row" {
+		return cell.GetColumnId()
+	}
+
+	return cell.GetRowId()
+}
+
+func formatSets(sets []*sudoku.Set) string {
+	set_strings := []string{}
+	for _, set := range sets {
+		set_strings = append(set_strings, set.String())
+	}
+
+	return strings.Join(set_strings, ", ")
+}
+
+// getFishLines returns the lines of the given orientation where the digit is
+// still unplaced, along with its candidate cells in each of them.
+func getFishLines(sets [27]*sudoku.Set, orientation string, digit int) ([]*sudoku.Set, [][]*sudoku.Cell) {
+	lines := sets[:9]
+	if orientation == "column" {
+		lines = sets[9:18]
+	}
+
+	fish_lines := []*sudoku.Set{}
+	fish_line_cells := [][]*sudoku.Cell{}
+
+	for _, line := range lines {
+		cells := getCellsWithPencilMark(line.Cells[:], digit)
+		if len(cells) < 2 {
+			continue
+		}
+
+		fish_lines = append(fish_lines, line)
+		fish_line_cells = append(fish_line_cells, cells)
+	}
+
+	return fish_lines, fish_line_cells
+}
+
+func findBasicFishForDigit(sets [27]*sudoku.Set, orientation string, digit int, size int) *Step {
+	cover_offset := 9
+	if orientation == "column" {
+		cover_offset = 0
+	}
+
+	lines, line_cells := getFishLines(sets, orientation, digit)
+
+	for _, combination := range getCombinations(len(lines), size) {
+		base_sets := []*sudoku.Set{}
+		base_cells := []*sudoku.Cell{}
+		cover_mask := 0
+
+		for _, index := range combination {
+			base_sets = append(base_sets, lines[index])
+			base_cells = append(base_cells, line_cells[index]...)
+
+			for _, cell := range line_cells[index] {
+				cover_mask |= 1 << (getCrossingLineIndex(cell, orientation) - 1)
+			}
+		}
+
+		if countDigitsInMask(cover_mask) != size {
+			continue
+		}
+
+		cover_sets := []*sudoku.Set{}
+		for _, cover_index := range getDigitsFromMask(cover_mask) {
+			cover_sets = append(cover_sets, sets[cover_offset+cover_index-1])
+		}
+
+		step := newStep(fish_names[size])
+		step.Description = fmt.Sprintf("%d in %s (base) is confined to %s (cover)", digit, formatSets(base_sets), formatSets(cover_sets))
+		step.Houses = append(step.Houses, base_sets...)
+		step.Houses = append(step.Houses, cover_sets...)
+		step.Cells = append(step.Cells, base_cells...)
+
+		for _, cover_set := range cover_sets {
+			for _, cell := range cover_set.Cells {
+				if !cellsContain(base_cells, cell) {
+					step.eliminate(cell, digit)
+				}
+			}
+		}
+
+		if step.hasChanges() {
+			return step
+		}
+	}
+
+	return nil
+}
+
+func getCommonBoxId(cells []*sudoku.Cell) int {
+	box_id := cells[0].GetBoxId()
+
+	for _, cell := range cells[1:] {
+		if cell.GetBoxId() != box_id {
+			return -1
+		}
+	}
+
+	return box_id
+}
+
+func findFinnedFishForCover(grid *sudoku.Grid, sets [27]*sudoku.Set, orientation string, base_sets []*sudoku.Set, base_line_cells [][]*sudoku.Cell, cover_mask int, digit int, sashimi bool) *Step {
+	cover_offset := 9
+	if orientation == "column" {
+		cover_offset = 0
+	}
+
+	base_cells := []*sudoku.Cell{}
+	fins := []*sudoku.Cell{}
+	degenerate := false
+
+	for _, cells := range base_line_cells {
+		covered_count := 0
+
+		for _, cell := range cells {
+			base_cells = append(base_cells, cell)
+
+			if cover_mask&(1<<(getCrossingLineIndex(cell, orientation)-1)) != 0 {
+				covered_count++
+			} else {
+				fins = append(fins, cell)
+			}
+		}
+
+		if covered_count == 0 {
+			return nil
+		}
+
+		if covered_count == 1 {
+			degenerate = true
+		}
+	}
+
+	if len(fins) == 0 || degenerate != sashimi {
+		return nil
+	}
+
+	fin_box_id := getCommonBoxId(fins)
+	if fin_box_id == -1 {
+		return nil
+	}
+
+	cells_in_fin_box, err := grid.GetCellsInBox(fin_box_id)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	cover_sets := []*sudoku.Set{}
+	for _, cover_index := range getDigitsFromMask(cover_mask) {
+		cover_sets = append(cover_sets, sets[cover_offset+cover_index-1])
+	}
+
+	size := len(base_sets)
+	technique := "Finned " + fish_names[size]
+	if sashimi {
+		technique = "Sashimi " + fish_names[size]
+	}
+
+	step := newStep(technique)
+	step.Description = fmt.Sprintf("%d in %s (base) is confined to %s (cover) except for fins %s in box %d", digit, formatSets(base_sets), formatSets(cover_sets), formatCells(fins), fin_box_id)
+	step.Houses = append(step.Houses, base_sets...)
+	step.Houses = append(step.Houses, cover_sets...)
+	step.Houses = append(step.Houses, sets[fin_box_id-1+18])
+	step.Cells = append(step.Cells, base_cells...)
+
+	for _, cell := range cells_in_fin_box {
+		if cellsContain(base_cells, cell) {
+			continue
+		}
+
+		if cover_mask&(1<<(getCrossingLineIndex(cell, orientation)-1)) != 0 {
+			step.eliminate(cell, digit)
+		}
+	}
+
+	if !step.hasChanges() {
+		return nil
+	}
+
+	return step
+}
+
+func findFinnedFishForDigit(grid *sudoku.Grid, sets [27]*sudoku.Set, orientation string, digit int, size int, sashimi bool) *Step {
+	lines, line_cells := getFishLines(sets, orientation, digit)
+
+	for _, combination := range getCombinations(len(lines), size) {
+		base_sets := []*sudoku.Set{}
+		base_line_cells := [][]*sudoku.Cell{}
+		candidate_mask := 0
+
+		for _, index := range combination {
+			base_sets = append(base_sets, lines[index])
+			base_line_cells = append(base_line_cells, line_cells[index])
+
+			for _, cell := range line_cells[index] {
+				candidate_mask |= 1 << (getCrossingLineIndex(cell, orientation) - 1)
+			}
+		}
+
+		// Fins are confined to a single box, so at most 3 extra crossing lines are involved.
+		candidate_indices := getDigitsFromMask(candidate_mask)
+		if len(candidate_indices) <= size || len(candidate_indices) > size+3 {
+			continue
+		}
+
+		for _, cover_combination := range getCombinations(len(candidate_indices), size) {
+			cover_mask := 0
+			for _, index := range cover_combination {
+				cover_mask |= 1 << (candidate_indices[index] - 1)
+			}
+
+			if step := findFinnedFishForCover(grid, sets, orientation, base_sets, base_line_cells, cover_mask, digit, sashimi); step != nil {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
+
+func findFinnedFish(grid *sudoku.Grid, size int, sashimi bool) (*Step, error) {
+	sets := grid.GetSets()
+
+	for digit := 1; digit <= 9; digit++ {
+		for _, orientation := range []string{"row", "column"} {
+			if step := findFinnedFishForDigit(grid, sets, orientation, digit, size, sashimi); step != nil {
+				return step, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func findBasicFish(grid *sudoku.Grid, size int) (*Step, error) {
+	sets := grid.GetSets()
+
+	for digit := 1; digit <= 9; digit++ {
+		for _, orientation := range []string{"row", "column"} {
+			if step := findBasicFishForDigit(sets, orientation, digit, size); step != nil {
+				return step, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func XWing(grid *sudoku.Grid) (*Step, error) {
+	return findBasicFish(grid, 2)
+}
+
+func Swordfish(grid *sudoku.Grid) (*Step, error) {
+	return findBasicFish(grid, 3)
+}
+
+func Jellyfish(grid *sudoku.Grid) (*Step, error) {
+	return findBasicFish(grid, 4)
+}
+
+func FinnedXWing(grid *sudoku.Grid) (*Step, error) {
+	return findFinnedFish(grid, 2, false)
+}
+
+func FinnedSwordfish(grid *sudoku.Grid) (*Step, error) {
+	return findFinnedFish(grid, 3, false)
+}
+
+func FinnedJellyfish(grid *sudoku.Grid) (*Step, error) {
+	return findFinnedFish(grid, 4, false)
+}
+
+func SashimiXWing(grid *sudoku.Grid) (*Step, error) {
+	return findFinnedFish(grid, 2, true)
+}
+
+func SashimiSwordfish(grid *sudoku.Grid) (*Step, error) {
+	return findFinnedFish(grid, 3, true)
+}
+
+func SashimiJellyfish(grid *sudoku.Grid) (*Step, error) {
+	return findFinnedFish(grid, 4, true)
+}
@@ -0,0 +1,1140 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestXWing(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       | 1     |       || 1     |       |       ||
+||  (7)  |  (3)  |  (2)  ||  (8)  |       | 4     || 4     |  (5)  |  (6)  ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (5)  |  (7)  |  (6)  ||  (9)  |  (3)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2   |   2   ||       |       | 1     ||
+||  (6)  |  (9)  |  (5)  ||  (3)  |       | 4     ||  (8)  |  (7)  | 4     ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (7)  |  (9)  ||  (1)  |  (4)  |  (8)  ||  (3)  |  (6)  |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |   2   |       ||
+||  (4)  |  (1)  |  (3)  ||       |  (6)  |  (5)  ||  (7)  |       |  (8)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       || 1 2   | 1 2   | 1     ||
+||  (5)  |  (8)  |  (6)  ||  (7)  |       |  (3)  || 4     | 4     | 4     ||
+||       |       |       ||       |     9 |       ||       |     9 |     9 ||
+##=======================##=======================##=======================##
+||       |       |       ||   2   |       |       ||   2   |   2   |       ||
+||  (1)  |  (6)  |  (8)  ||       |  (5)  |  (7)  || 4     | 4     |  (3)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       | 1 2   | 1     ||
+||  (3)  |  (5)  |  (7)  ||  (4)  |  (8)  |       ||  (6)  |       |       ||
+||       |       |       ||       |       |     9 ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (2)  |  (4)  ||  (6)  |  (3)  |  (1)  ||  (5)  |  (8)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       | 1     |       || 1     |       |       ||
+||  (7)  |  (3)  |  (2)  ||  (8)  |       | 4     || 4     |  (5)  |  (6)  ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (5)  |  (7)  |  (6)  ||  (9)  |  (3)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2   |   2   ||       |       | 1     ||
+||  (6)  |  (9)  |  (5)  ||  (3)  |       | 4     ||  (8)  |  (7)  | 4     ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (7)  |  (9)  ||  (1)  |  (4)  |  (8)  ||  (3)  |  (6)  |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |   2   |       ||
+||  (4)  |  (1)  |  (3)  ||       |  (6)  |  (5)  ||  (7)  |       |  (8)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       || 1 2   | 1 2   | 1     ||
+||  (5)  |  (8)  |  (6)  ||  (7)  |       |  (3)  || 4     | 4     | 4     ||
+||       |       |       ||       |     9 |       ||       |       |     9 ||
+##=======================##=======================##=======================##
+||       |       |       ||   2   |       |       ||   2   |   2   |       ||
+||  (1)  |  (6)  |  (8)  ||       |  (5)  |  (7)  || 4     | 4     |  (3)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       | 1 2   | 1     ||
+||  (3)  |  (5)  |  (7)  ||  (4)  |  (8)  |       ||  (6)  |       |       ||
+||       |       |       ||       |       |     9 ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (2)  |  (4)  ||  (6)  |  (3)  |  (1)  ||  (5)  |  (8)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "X-Wing: 9 in row 5, row 7 (base) is confined to column 4, column 8 (cover) => r6c8<>9, r8c8<>9")
+}
+
+func TestSwordfish(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |   2   ||       |       |   2   ||
+||  (6)  |  (9)  |  (1)  ||  (7)  |  (5)  |       ||  (4)  |  (3)  |       ||
+||       |       |       ||       |       |   8   ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||   2   |     3 | 1   3 ||   2   |       | 1     ||
+||  (8)  | 4 5   |  (7)  ||       | 4     | 4     ||   5   |  (6)  |       ||
+||       |       |       ||     9 |       |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       | 1   3 ||       |       | 1     ||
+|| 4 5   | 4 5   |  (2)  ||       |  (6)  | 4     ||  (7)  |   5   |       ||
+||       |       |       ||   8 9 |       |       ||       |   8   |     9 ||
+##=======================##=======================##=======================##
+||   2   |   2   |       ||       |     3 |   2 3 ||       |       |   2   ||
+|| 4 5   | 4 5   | 4 5   ||  (6)  | 4     | 4     ||  (8)  |  (1)  | 4 5   ||
+|| 7   9 |       |     9 ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |       ||       |       |   2   ||       |       |   2   ||
+|| 4 5   |  (6)  | 4 5   ||  (1)  |  (9)  | 4     ||  (3)  |   5   | 4 5   ||
+|| 7     |       |   8   ||       |       |   8   ||       | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   | 1 2   |       ||   2   |       |       ||       |   2   |       ||
+|| 4     | 4     |  (3)  ||       |  (7)  |  (5)  ||  (9)  | 4     |  (6)  ||
+||       |   8   |       ||   8   |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |   5   |  (6)  ||  (4)  |  (2)  |  (9)  ||  (1)  |   5   |   5   ||
+||       |   8   |       ||       |       |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   | 1 2   |       ||       |       |       ||       |   2   |       ||
+|| 4     | 4     | 4     ||  (5)  |  (8)  |  (7)  ||  (6)  | 4     |  (3)  ||
+||     9 |       |     9 ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |       ||       |       |       ||   2   |       |   2   ||
+|| 4 5   |  (7)  | 4 5   ||  (3)  |  (1)  |  (6)  ||   5   |  (9)  | 4 5   ||
+||       |       |   8   ||       |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |   2   ||       |       |   2   ||
+||  (6)  |  (9)  |  (1)  ||  (7)  |  (5)  |       ||  (4)  |  (3)  |       ||
+||       |       |       ||       |       |   8   ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||   2   |     3 | 1   3 ||   2   |       | 1     ||
+||  (8)  | 4 5   |  (7)  ||       | 4     | 4     ||   5   |  (6)  |       ||
+||       |       |       ||     9 |       |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       | 1   3 ||       |       | 1     ||
+|| 4 5   | 4 5   |  (2)  ||       |  (6)  | 4     ||  (7)  |   5   |       ||
+||       |       |       ||   8 9 |       |       ||       |   8   |     9 ||
+##=======================##=======================##=======================##
+||   2   |   2   |       ||       |     3 |   2 3 ||       |       |   2   ||
+|| 4 5   | 4 5   | 4 5   ||  (6)  | 4     | 4     ||  (8)  |  (1)  | 4 5   ||
+|| 7   9 |       |     9 ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |       ||       |       |   2   ||       |       |   2   ||
+|| 4 5   |  (6)  | 4 5   ||  (1)  |  (9)  | 4     ||  (3)  |   5   | 4 5   ||
+|| 7     |       |   8   ||       |       |   8   ||       | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   | 1 2   |       ||   2   |       |       ||       |   2   |       ||
+|| 4     | 4     |  (3)  ||       |  (7)  |  (5)  ||  (9)  | 4     |  (6)  ||
+||       |   8   |       ||   8   |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |   5   |  (6)  ||  (4)  |  (2)  |  (9)  ||  (1)  |   5   |   5   ||
+||       |   8   |       ||       |       |       ||       | 7 8   | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   | 1 2   |       ||       |       |       ||       |   2   |       ||
+|| 4     | 4     | 4     ||  (5)  |  (8)  |  (7)  ||  (6)  | 4     |  (3)  ||
+||     9 |       |     9 ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |       ||       |       |       ||   2   |       |   2   ||
+|| 4 5   |  (7)  | 4 5   ||  (3)  |  (1)  |  (6)  ||   5   |  (9)  | 4 5   ||
+||       |       |   8   ||       |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Swordfish(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Swordfish: 8 in row 1, row 5, row 9 (base) is confined to column 3, column 6, column 9 (cover) => r7c9<>8")
+}
+
+func TestJellyfish(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+|| 4   6 |  (1)  |  (7)  ||  (2)  |  (5)  | 4   6 ||  (8)  |  (9)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 || 1     |       |       ||       | 1     |       ||
+|| 4   6 |  (8)  | 4     || 4   6 |  (9)  |  (7)  ||  (2)  | 4     |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1   3 | 1   3 |       || 1     |       |       ||
+||  (9)  |  (2)  |  (5)  || 4     |       |  (8)  || 4     |  (7)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |     3 |       ||     3 |       |       ||
+|| 4     |  (6)  |  (2)  ||  (9)  |       |  (1)  || 4     |  (5)  |  (7)  ||
+||   8   |       |       ||       |   8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 ||       |       |       ||       | 1     |       ||
+||       |  (9)  |       ||  (7)  |  (4)  |  (5)  ||  (6)  |       |  (2)  ||
+||   8   |       |   8   ||       |       |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||     3 |       |       || 1   3 | 1     |       ||
+||  (7)  |  (5)  | 4     ||       |  (6)  |  (2)  || 4     | 4     |  (9)  ||
+||       |       |   8   ||   8   |       |       ||       |   8   |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       |       |       ||       |       | 1     ||
+||  (5)  |  (3)  |       || 4   6 |  (7)  | 4   6 ||  (9)  |  (2)  |       ||
+||       |       |   8   ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       |       ||
+||  (2)  |  (7)  |  (6)  ||       |       |  (9)  ||  (5)  |  (3)  |  (4)  ||
+||       |       |       ||   8   |   8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |       |       ||       |       | 1     ||
+||       |  (4)  |  (9)  ||  (5)  |  (2)  |  (3)  ||  (7)  |  (6)  |       ||
+||   8   |       |       ||       |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+|| 4   6 |  (1)  |  (7)  ||  (2)  |  (5)  | 4   6 ||  (8)  |  (9)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 || 1     |       |       ||       | 1     |       ||
+||     6 |  (8)  | 4     ||     6 |  (9)  |  (7)  ||  (2)  | 4     |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1   3 | 1   3 |       || 1     |       |       ||
+||  (9)  |  (2)  |  (5)  || 4     |       |  (8)  || 4     |  (7)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |     3 |       ||     3 |       |       ||
+|| 4     |  (6)  |  (2)  ||  (9)  |       |  (1)  || 4     |  (5)  |  (7)  ||
+||   8   |       |       ||       |   8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 ||       |       |       ||       | 1     |       ||
+||       |  (9)  |       ||  (7)  |  (4)  |  (5)  ||  (6)  |       |  (2)  ||
+||   8   |       |   8   ||       |       |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||     3 |       |       || 1   3 | 1     |       ||
+||  (7)  |  (5)  | 4     ||       |  (6)  |  (2)  ||       | 4     |  (9)  ||
+||       |       |   8   ||   8   |       |       ||       |   8   |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       |       |       ||       |       | 1     ||
+||  (5)  |  (3)  |       || 4   6 |  (7)  | 4   6 ||  (9)  |  (2)  |       ||
+||       |       |   8   ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       |       ||
+||  (2)  |  (7)  |  (6)  ||       |       |  (9)  ||  (5)  |  (3)  |  (4)  ||
+||       |       |       ||   8   |   8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |       |       ||       |       | 1     ||
+||       |  (4)  |  (9)  ||  (5)  |  (2)  |  (3)  ||  (7)  |  (6)  |       ||
+||   8   |       |       ||       |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Jellyfish(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Jellyfish: 4 in row 1, row 3, row 4, row 7 (base) is confined to column 1, column 4, column 6, column 7 (cover) => r2c1<>4, r2c4<>4, r6c7<>4")
+}
+
+func TestXWingNothingToEliminate(t *testing.T) {
+	// 3 in row 2 and row 6 is confined to column 7 and column 9, but no other
+	// cell of those columns holds 3.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |   5   ||
+|| 7   9 | 7   9 |       ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |  (7)  || 4     | 4 5   |   5   ||
+||       |       |       ||   8   |       |       ||   8   |   8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XWing(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestSwordfishNothingToEliminate(t *testing.T) {
+	// 6 in row 4, row 5 and row 6 is confined to column 3, column 4 and column
+	// 8, but no other cell of those columns holds 6.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||   2   |   2   |       ||
+||  (1)  |  (7)  |  (8)  ||  (5)  |  (3)  |  (6)  || 4     | 4     |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (6)  |  (2)  ||  (8)  |  (4)  |  (7)  ||  (1)  |  (5)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       |       |       ||       |       |       ||
+|| 4     |  (5)  | 4     ||  (1)  |  (2)  |  (9)  ||       |       |  (6)  ||
+||       |       |       ||       |       |       || 7 8   | 7 8   |       ||
+##=======================##=======================##=======================##
+||       |   2 3 | 1     ||   2   | 1     | 1 2 3 ||   2 3 | 1 2   |       ||
+||  (5)  | 4     |     6 || 4   6 |       |       ||       |     6 |  (8)  ||
+||       |       |       ||     9 |     9 |       || 7   9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2 3 |       ||   2   |       | 1 2 3 ||   2 3 | 1 2   |       ||
+||  (8)  |       |  (7)  ||     6 |  (5)  |       ||       |     6 |  (4)  ||
+||       |       |       ||     9 |       |       ||     9 |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       | 1     ||   2   |       |       ||   2 3 | 1 2   |       ||
+|| 4     |  (9)  |     6 || 4   6 |  (7)  |  (8)  ||       |     6 |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |     3 |     3 ||       |       |       ||       |       |       ||
+||  (2)  |       |       ||  (7)  |  (6)  |  (4)  ||  (5)  |       |  (1)  ||
+||       |   8   |     9 ||       |       |       ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (1)  | 4     ||  (3)  |       |  (5)  ||  (6)  | 4     |  (2)  ||
+||       |       |     9 ||       |   8 9 |       ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   | 1     | 1 2   ||       |       |       ||
+||  (6)  | 4     |  (5)  ||       |       |       || 4     |  (3)  |  (7)  ||
+||       |   8   |       ||     9 |   8 9 |       ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Swordfish(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestJellyfishNothingToEliminate(t *testing.T) {
+	// 7 in row 2, row 4, row 5 and row 6 is confined to column 3, column 5,
+	// column 6 and column 9, but no other cell of those columns holds 7.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |   2   |       ||       |       |       ||       |       |   2   ||
+||  (3)  |       |  (5)  ||  (1)  |  (4)  |  (6)  ||  (7)  |       |       ||
+||       |     9 |       ||       |       |       ||       |   8 9 |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   | 1     ||       |   2   |       || 1     |       |       ||
+||  (4)  |       |       ||  (3)  |       |       ||     6 |     6 |  (5)  ||
+||       |     9 |   8   ||       | 7     | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |   2   |       || 1   3 |       |   2 3 ||
+||  (7)  |  (6)  |       ||  (5)  |       |       ||       |  (4)  |       ||
+||       |       |   8   ||       |     9 |   8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  | 4     ||  (9)  |  (8)  |  (1)  || 4   6 |  (5)  |     6 ||
+||       |       | 7     ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |     3 |     3 ||
+||  (6)  |  (1)  |  (9)  ||  (2)  |   5   | 4 5   || 4     |       |       ||
+||       |       |       ||       | 7     | 7     ||       |   8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (8)  | 4     ||  (6)  |  (3)  | 4     ||  (2)  |  (1)  |  (9)  ||
+||       |       | 7     ||       |       | 7     ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||  (1)  |  (7)  |  (2)  ||  (4)  |   5   |   5   ||  (8)  |     6 |     6 ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (5)  |  (6)  ||  (7)  |  (1)  |  (3)  ||  (9)  |  (2)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (4)  |  (3)  ||  (8)  |  (6)  |  (2)  ||  (5)  |  (7)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Jellyfish(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestFinnedXWing(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |   5   ||
+|| 7   9 | 7   9 |       || 7     |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     || 7 8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |   5   || 4     | 4 5   |   5   ||
+||       |       |       || 7 8   |       | 7     || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||   5   |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||   5   |  (2)  |       ||  (3)  |  (4)  |   5   ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       | 7     || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |   5   ||
+|| 7   9 | 7   9 |       || 7     |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     || 7 8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |   5   || 4     | 4 5   |   5   ||
+||       |       |       || 7 8   |       | 7     || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||   5   |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |   5   ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       | 7     || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := FinnedXWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Finned X-Wing: 5 in row 5, row 8 (base) is confined to column 1, column 4 (cover) except for fins r8c2 in box 7 => r9c1<>5")
+}
+
+func TestSashimiXWing(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||     3 |       |     3 ||       |       |       ||       |     3 |       ||
+|| 4     |  (2)  | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |  (1)  ||
+||     9 |       |     9 ||       |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||       |       |       ||
+||       |  (6)  |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |  (2)  ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 || 1 2   | 1 2   |       ||     3 |     3 |       ||
+||       |   5   |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7   9 | 7     |     9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |       ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |       ||       |       |       ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 ||       |     3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7     |   8 9 || 7 8   |     9 |       ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |       ||
+|| 4     |  (1)  | 4     ||  (3)  |       |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |       ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||     3 |       |     3 ||       |       |       ||       |     3 |       ||
+|| 4     |  (2)  | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |  (1)  ||
+||     9 |       |     9 ||       |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||       |       |       ||
+||       |  (6)  |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |  (2)  ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 || 1 2   | 1 2   |       ||     3 |     3 |       ||
+||       |   5   |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7   9 | 7     |     9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |       ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |       ||       |       |       ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 ||       |     3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7     |   8 9 || 7 8   |     9 |       ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |       ||
+|| 4     |  (1)  | 4     ||  (3)  |       |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2   | 1     ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |       ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := SashimiXWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Sashimi X-Wing: 2 in row 6, row 7 (base) is confined to column 6, column 8 (cover) except for fins r7c5 in box 8 => r8c6<>2")
+}
+
+func TestFinnedSwordfish(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1 2   | 1     | 1 2   ||       |       |   2   ||       | 1     | 1     ||
+|| 4 5   | 4     | 4     ||  (6)  |  (3)  |       ||  (7)  | 4 5   | 4     ||
+||     9 |     9 |     9 ||       |       |     9 ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |       |       ||       |       | 1     ||
+||   5   |  (6)  |  (3)  ||  (4)  |  (8)  |  (7)  ||   5   |  (2)  |       ||
+||     9 |       |       ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |       ||       |   2   |       ||       |       |       ||
+|| 4     |  (8)  |  (7)  ||  (5)  |       |  (1)  || 4     |  (6)  |  (3)  ||
+||     9 |       |       ||       |     9 |       ||     9 |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (2)  | 4     ||  (1)  | 4     |  (6)  ||  (3)  | 4     |  (5)  ||
+|| 7 8   |       |     9 ||       | 7     |       ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     | 1     ||       |   2   |       ||   2   |       | 1 2   ||
+||  (6)  | 4     | 4 5   ||  (8)  | 4 5   |  (3)  || 4     |  (7)  | 4     ||
+||       |     9 |     9 ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |   2   |   2   ||       | 1     | 1 2   ||
+||       |  (3)  | 4 5   ||  (9)  | 4 5   | 4     ||  (6)  | 4     | 4     ||
+|| 7 8   |       |       ||       | 7     |       ||       |   8   |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     |       ||       |       |       ||
+||  (3)  | 4     |  (6)  ||  (2)  | 4     |  (5)  ||  (8)  | 4     |  (7)  ||
+||       |     9 |       ||       |     9 |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |       ||       |       |       ||       |       |   2   ||
+|| 4     |  (5)  |  (8)  ||  (7)  |  (6)  | 4     ||  (1)  |  (3)  | 4     ||
+||     9 |       |       ||       |       |     9 ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   |       | 1 2   ||       | 1     |       ||   2   |       |       ||
+|| 4     |  (7)  | 4     ||  (3)  | 4     |  (8)  || 4 5   | 4 5   |  (6)  ||
+||     9 |       |     9 ||       |     9 |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1 2   | 1     | 1 2   ||       |       |   2   ||       | 1     | 1     ||
+|| 4 5   | 4     | 4     ||  (6)  |  (3)  |       ||  (7)  | 4 5   | 4     ||
+||     9 |     9 |     9 ||       |       |     9 ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |       |       ||       |       | 1     ||
+||   5   |  (6)  |  (3)  ||  (4)  |  (8)  |  (7)  ||   5   |  (2)  |       ||
+||     9 |       |       ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |       ||       |   2   |       ||       |       |       ||
+|| 4     |  (8)  |  (7)  ||  (5)  |       |  (1)  || 4     |  (6)  |  (3)  ||
+||     9 |       |       ||       |     9 |       ||     9 |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (2)  | 4     ||  (1)  | 4     |  (6)  ||  (3)  | 4     |  (5)  ||
+|| 7 8   |       |     9 ||       | 7     |       ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     | 1     ||       |   2   |       ||   2   |       | 1 2   ||
+||  (6)  | 4     | 4 5   ||  (8)  | 4 5   |  (3)  || 4     |  (7)  | 4     ||
+||       |     9 |     9 ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |   2   |   2   ||       | 1     | 1     ||
+||       |  (3)  | 4 5   ||  (9)  | 4 5   | 4     ||  (6)  | 4     | 4     ||
+|| 7 8   |       |       ||       | 7     |       ||       |   8   |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     |       ||       |       |       ||
+||  (3)  | 4     |  (6)  ||  (2)  | 4     |  (5)  ||  (8)  | 4     |  (7)  ||
+||       |     9 |       ||       |     9 |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |       ||       |       |       ||       |       |   2   ||
+|| 4     |  (5)  |  (8)  ||  (7)  |  (6)  | 4     ||  (1)  |  (3)  | 4     ||
+||     9 |       |       ||       |       |     9 ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   |       | 1 2   ||       | 1     |       ||   2   |       |       ||
+|| 4     |  (7)  | 4     ||  (3)  | 4     |  (8)  || 4 5   | 4 5   |  (6)  ||
+||     9 |       |     9 ||       |     9 |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := FinnedSwordfish(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Finned Swordfish: 2 in row 3, row 5, row 8 (base) is confined to column 1, column 5, column 9 (cover) except for fins r5c7 in box 6 => r6c9<>2")
+}
+
+func TestSashimiSwordfish(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (9)  ||  (3)  |       |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |       ||       | 7     |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       | 1     | 1     || 1     |       |       ||
+||     6 |  (4)  |       ||  (5)  |       |     6 ||       |  (2)  |  (9)  ||
+|| 7     |       | 7     ||       | 7 8   |   8   || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||   5 6 |  (2)  |   5   ||     6 |       |  (4)  ||  (3)  |     6 |       ||
+|| 7     |       | 7     ||   8 9 | 7 8 9 |       ||       | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||     3 |       | 1   3 || 1     | 1 2   | 1 2   ||       |       |       ||
+||       |  (5)  |       ||     6 |       |     6 || 4     | 4     |       ||
+|| 7 8 9 |       | 7 8   ||   8 9 |   8 9 |   8 9 || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (6)  |  (2)  ||       |  (4)  |  (3)  ||       |  (1)  |  (5)  ||
+|| 7 8 9 |       |       ||   8 9 |       |       || 7 8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       || 1     |       |       ||       |       |       ||
+||       |       |  (4)  ||       |  (5)  |  (7)  ||  (2)  |  (3)  |  (6)  ||
+||   8 9 |     9 |       ||   8 9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1     | 1     ||       |       |       ||
+||  (2)  |  (7)  |   5   ||  (4)  |       |       ||  (6)  |   5   |  (3)  ||
+||       |       |   8   ||       |   8 9 |   8 9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       || 1     |       | 1     ||
+||  (4)  |  (3)  |       ||  (2)  |  (6)  |  (5)  ||       |       |       ||
+||       |       |   8   ||       |       |       || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       | 1     || 1     |       |       ||
+||   5   |       |  (6)  ||  (7)  |  (3)  |       || 4     | 4 5   |  (2)  ||
+||   8 9 |     9 |       ||       |       |   8   ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (9)  ||  (3)  |       |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |       ||       | 7     |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       | 1     | 1     || 1     |       |       ||
+||     6 |  (4)  |       ||  (5)  |       |     6 ||       |  (2)  |  (9)  ||
+|| 7     |       | 7     ||       | 7 8   |   8   || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||   5 6 |  (2)  |   5   ||     6 |       |  (4)  ||  (3)  |     6 |       ||
+|| 7     |       | 7     ||     9 | 7 8 9 |       ||       | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||     3 |       | 1   3 || 1     | 1 2   | 1 2   ||       |       |       ||
+||       |  (5)  |       ||     6 |       |     6 || 4     | 4     |       ||
+|| 7 8 9 |       | 7 8   ||   8 9 |   8 9 |   8 9 || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (6)  |  (2)  ||       |  (4)  |  (3)  ||       |  (1)  |  (5)  ||
+|| 7 8 9 |       |       ||   8 9 |       |       || 7 8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       || 1     |       |       ||       |       |       ||
+||       |       |  (4)  ||       |  (5)  |  (7)  ||  (2)  |  (3)  |  (6)  ||
+||   8 9 |     9 |       ||   8 9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1     | 1     ||       |       |       ||
+||  (2)  |  (7)  |   5   ||  (4)  |       |       ||  (6)  |   5   |  (3)  ||
+||       |       |   8   ||       |   8 9 |   8 9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       || 1     |       | 1     ||
+||  (4)  |  (3)  |       ||  (2)  |  (6)  |  (5)  ||       |       |       ||
+||       |       |   8   ||       |       |       || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       | 1     || 1     |       |       ||
+||   5   |       |  (6)  ||  (7)  |  (3)  |       || 4     | 4 5   |  (2)  ||
+||   8 9 |     9 |       ||       |       |   8   ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := SashimiSwordfish(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Sashimi Swordfish: 8 in row 2, row 5, row 6 (base) is confined to column 1, column 4, column 7 (cover) except for fins r2c5, r2c6 in box 2 => r3c4<>8")
+}
+
+func TestFinnedJellyfish(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       | 1   3 | 1   3 ||       |       |       ||     3 |     3 |       ||
+||  (5)  |       |       ||  (6)  |  (2)  |  (7)  || 4     | 4     |  (9)  ||
+||       |   8   |   8   ||       |       |       ||   8   |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       | 1   3 ||       |   2 3 | 1 2   ||
+||  (9)  |  (4)  |  (6)  ||  (5)  |  (8)  |       ||  (7)  |       |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||     3 | 1   3 |       ||       |       | 1     ||
+||       |       |  (2)  || 4     | 4     |  (9)  ||  (5)  |  (6)  |       ||
+|| 7 8   | 7 8   |       ||       |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+|| 1 2 3 |       |       ||       | 1   3 | 1   3 || 1 2   |   2   |       ||
+|| 4     |  (9)  |  (5)  ||  (7)  | 4     | 4     || 4     | 4     |  (6)  ||
+||   8   |       |       ||       |       |   8   ||   8   |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1     | 1     ||       |       |       || 1     |       |       ||
+|| 4   6 |     6 |       || 4     |  (5)  |  (2)  || 4     |  (9)  |  (3)  ||
+|| 7 8   | 7 8   | 7 8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1   3 ||     3 | 1   3 | 1   3 || 1 2   |       |       ||
+|| 4     |       |       || 4     | 4   6 | 4   6 || 4     |  (5)  |  (7)  ||
+||   8   |   8   |   8   ||   8 9 |     9 |   8   ||   8   |       |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1   3 |       ||       |     3 |     3 ||       |     3 |       ||
+||       |       |  (9)  ||  (2)  | 4     | 4 5   ||  (6)  |       | 4 5   ||
+|| 7 8   | 7 8   |       ||       | 7     |   8   ||       |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |       |     3 ||     3 |     3 |     3 ||   2 3 |       |   2   ||
+||     6 |  (5)  |       || 4     | 4   6 | 4   6 ||       |  (1)  | 4     ||
+|| 7 8   |       | 7 8   ||   8 9 | 7   9 |   8   ||   8   |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |   2 3 |       ||       |     3 |     3 ||       |       |   2   ||
+||     6 |     6 |  (4)  ||  (1)  |     6 |   5 6 ||  (9)  |  (7)  |   5   ||
+||   8   |   8   |       ||       |       |   8   ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       | 1   3 | 1   3 ||       |       |       ||     3 |     3 |       ||
+||  (5)  |       |       ||  (6)  |  (2)  |  (7)  || 4     | 4     |  (9)  ||
+||       |   8   |   8   ||       |       |       ||   8   |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       | 1   3 ||       |   2 3 | 1 2   ||
+||  (9)  |  (4)  |  (6)  ||  (5)  |  (8)  |       ||  (7)  |       |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||     3 | 1   3 |       ||       |       | 1     ||
+||       |       |  (2)  || 4     | 4     |  (9)  ||  (5)  |  (6)  |       ||
+|| 7 8   | 7 8   |       ||       |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+|| 1 2 3 |       |       ||       | 1   3 | 1   3 || 1 2   |   2   |       ||
+|| 4     |  (9)  |  (5)  ||  (7)  | 4     | 4     || 4     | 4     |  (6)  ||
+||   8   |       |       ||       |       |   8   ||   8   |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1     | 1     ||       |       |       || 1     |       |       ||
+|| 4   6 |     6 |       || 4     |  (5)  |  (2)  || 4     |  (9)  |  (3)  ||
+|| 7 8   | 7 8   | 7 8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1   3 ||     3 | 1   3 | 1   3 || 1 2   |       |       ||
+|| 4     |       |       || 4     | 4   6 | 4   6 || 4     |  (5)  |  (7)  ||
+||   8   |   8   |   8   ||   8 9 |     9 |   8   ||   8   |       |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1   3 |       ||       |     3 |     3 ||       |     3 |       ||
+||       |       |  (9)  ||  (2)  | 4     | 4 5   ||  (6)  |       | 4 5   ||
+|| 7 8   | 7 8   |       ||       | 7     |   8   ||       |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |     3 ||     3 |     3 |     3 ||   2 3 |       |   2   ||
+||     6 |  (5)  |       || 4     | 4   6 | 4   6 ||       |  (1)  | 4     ||
+|| 7 8   |       | 7 8   ||   8 9 | 7   9 |   8   ||   8   |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |   2 3 |       ||       |     3 |     3 ||       |       |   2   ||
+||     6 |     6 |  (4)  ||  (1)  |     6 |   5 6 ||  (9)  |  (7)  |   5   ||
+||   8   |   8   |       ||       |       |   8   ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := FinnedJellyfish(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Finned Jellyfish: 3 in row 2, row 4, row 7, row 9 (base) is confined to column 1, column 5, column 6, column 8 (cover) except for fins r7c2, r9c2 in box 7 => r8c1<>3")
+}
+
+func TestSashimiJellyfish(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |   5   ||
+|| 7   9 | 7   9 |       || 7     |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     || 7 8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |   5   || 4     | 4 5   |   5   ||
+||       |       |       || 7 8   |       | 7     || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||   5   |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||   5   |  (2)  |       ||  (3)  |  (4)  |   5   ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       | 7     || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |   5   ||
+|| 7   9 | 7   9 |       || 7     |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     || 7 8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |   5   || 4     | 4 5   |   5   ||
+||       |       |       || 7 8   |       | 7     || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||   5   |  (2)  |       ||  (3)  |  (4)  |   5   ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       | 7     || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := SashimiJellyfish(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Sashimi Jellyfish: 5 in column 2, column 6, column 8, column 9 (base) is confined to row 1, row 4, row 6, row 8 (cover) except for fins r9c6 in box 8 => r8c4<>5")
+}
+
+func TestFinnedXWingFinsInTwoBoxes(t *testing.T) {
+	// 7 in row 5 and row 9 is confined to column 1 and column 7 except for r5c3
+	// and r9c3, but those fins lie in box 4 and box 7.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |   5   ||
+|| 7   9 | 7   9 |       ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |  (7)  || 4     | 4 5   |   5   ||
+||       |       |       ||   8   |       |       ||   8   |   8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := FinnedXWing(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestFinnedSwordfishFinBoxOutsideCover(t *testing.T) {
+	// 4 in row 1, row 4 and row 8 is confined to column 4, column 5 and column 6
+	// except for the fin r8c8, but box 9 shares no cell with those columns.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||       |     3 |     3 ||       |       |       ||
+||  (2)  |       |  (7)  || 4 5 6 | 4 5   | 4   6 ||     6 |  (1)  |       ||
+||       |     9 |       ||       |     9 |     9 ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       | 1     ||       |       |       ||
+||  (8)  |   5   |   5 6 ||  (7)  |  (2)  |     6 ||  (4)  |     6 |  (3)  ||
+||       |     9 |     9 ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     || 1     | 1   3 |       ||       |       |       ||
+||       |  (4)  |     6 ||     6 |       |  (8)  ||  (2)  |  (5)  |  (7)  ||
+||       |       |     9 ||       |     9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     | 1     ||       |       |       ||
+||  (6)  |       |  (3)  ||  (2)  | 4     | 4     ||       |  (7)  |  (5)  ||
+||       |   8 9 |       ||       |     9 |     9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |   2   ||       | 1     |       ||       |       |       ||
+||  (5)  |       |       ||  (8)  |     6 |  (7)  ||     6 |  (3)  |  (4)  ||
+||       |     9 |     9 ||       |     9 |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |   2   |   2   ||
+||  (7)  |       |  (4)  ||  (3)  |     6 |  (5)  ||  (1)  |     6 |       ||
+||       |   8 9 |       ||       |     9 |       ||       |     9 |   8 9 ||
+##=======================##=======================##=======================##
+|| 1   3 |   2 3 |   2   || 1     |       | 1   3 ||       |       | 1     ||
+|| 4     |   5   |   5   || 4 5 6 |  (8)  | 4   6 ||  (7)  | 4     |       ||
+||       |       |       ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       | 1   3 ||     3 |   2   | 1 2   ||
+||  (9)  |  (6)  |  (8)  || 4 5   |  (7)  | 4     ||   5   | 4     |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     ||       | 1   3 |       ||     3 |       |       ||
+|| 4     |  (7)  |   5   ||  (9)  | 4 5   |  (2)  ||   5   |  (8)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := FinnedSwordfish(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestSashimiXWingNothingToEliminate(t *testing.T) {
+	// 9 in row 2 and row 3 is confined to column 4 and column 8 except for the
+	// fin r2c6, but r1c4, the only cell of box 2 in column 4 outside those rows,
+	// holds no 9.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||     3 |       |       ||       |       |       ||
+||  (4)  |       |  (9)  ||       |  (6)  |  (8)  ||  (2)  |  (5)  |  (1)  ||
+||       | 7     |       || 7     |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |       ||       |     3 |       ||
+||  (1)  |  (8)  |  (2)  ||  (5)  | 4     | 4     ||  (6)  |       |  (7)  ||
+||       |       |       ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |       |       ||       |     3 |       ||
+||  (5)  |  (6)  |       ||       |  (1)  |  (2)  ||  (4)  |       |  (8)  ||
+||       |       | 7     || 7   9 |       |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (2)  | 4     ||  (8)  | 4     |  (1)  ||  (3)  |  (6)  |  (5)  ||
+||       |       | 7     ||       | 7     |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       |       |       ||       |       |       ||
+||     6 |  (1)  | 4     ||     6 |  (5)  | 4     ||  (8)  |  (7)  |  (2)  ||
+||       |       |       ||     9 |       |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |   2   |       ||       |       |       ||
+||     6 |  (5)  |  (8)  ||     6 |       |  (3)  ||  (9)  |  (1)  |  (4)  ||
+|| 7     |       |       ||       | 7     |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||   2 3 |   2 3 |       ||       |       |       ||
+||  (8)  |  (9)  |  (5)  ||       |       |  (7)  ||  (1)  |  (4)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (4)  |  (6)  ||  (1)  |  (9)  |  (5)  ||  (7)  |  (8)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |       ||
+||       |       |  (1)  ||  (4)  |  (8)  |  (6)  ||  (5)  |  (2)  |  (9)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := SashimiXWing(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
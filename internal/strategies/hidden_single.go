@@ -16,7 +16,7 @@ func pencilMarksContainDigit(pencil_marks []int, digit int) bool {
 	return false
 }
 
-func findHiddenSingleInSet(set *sudoku.Set) (bool, error) {
+func findHiddenSingleInSet(set *sudoku.Set) (*Step, error) {
 	for digit := 1; digit <= 9; digit++ {
 		var hidden_single_cell_candidate *sudoku.Cell = nil
 		value_found := false
@@ -43,25 +43,27 @@ func findHiddenSingleInSet(set *sudoku.Set) (bool, error) {
 		}
 
 		if hidden_single_cell_candidate == nil {
-			return false, fmt.Errorf("error in %s %d: no possible cell to place digit: %d", set.Orientation, set.Index, digit)
+			return nil, fmt.Errorf("error in %s %d: no possible cell to place digit: %d", set.Orientation, set.Index, digit)
 		}
 
-		if err := hidden_single_cell_candidate.SetValue(digit); err != nil {
-			panic(err.Error())
-		}
-		return true, nil
+		step := newStep("Hidden Single")
+		step.Description = fmt.Sprintf("%s is the only place for %d in %s", hidden_single_cell_candidate, digit, set)
+		step.Houses = append(step.Houses, set)
+		step.Cells = append(step.Cells, hidden_single_cell_candidate)
+		step.place(hidden_single_cell_candidate, digit)
+		return step, nil
 	}
 
-	return false, nil
+	return nil, nil
 }
 
-func HiddenSingle(grid *sudoku.Grid) (bool, error) {
+func HiddenSingle(grid *sudoku.Grid) (*Step, error) {
 	for _, set := range grid.GetSets() {
-		changed, err := findHiddenSingleInSet(set)
-		if err != nil || changed {
-			return changed, err
+		step, err := findHiddenSingleInSet(set)
+		if err != nil || step != nil {
+			return step, err
 		}
 	}
 
-	return false, nil
+	return nil, nil
 }
@@ -171,19 +171,23 @@ func TestHiddenSingle(t *testing.T) {
 	grid := sudoku.NewGrid()
 	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
 
-	changed, err := HiddenSingle(grid)
+	step, err := HiddenSingle(grid)
 	AssertNoError(t, err)
-	AssertChanged(t, changed)
+	AssertChanged(t, step)
 	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str_after_first_call)
 
-	changed, err = HiddenSingle(grid)
+	if step.String() != "Hidden Single: r1c1 is the only place for 1 in row 1 => r1c1=1" {
+		t.Errorf("unexpected step: %s", step)
+	}
+
+	step, err = HiddenSingle(grid)
 	AssertNoError(t, err)
-	AssertChanged(t, changed)
+	AssertChanged(t, step)
 	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str_after_second_call)
 
-	changed, err = HiddenSingle(grid)
+	step, err = HiddenSingle(grid)
 	AssertNoError(t, err)
-	AssertChanged(t, changed)
+	AssertChanged(t, step)
 	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str_after_third_call)
 
 	// TODO: There are hidden singles still to find in this grid, so we cannot assert for the next call to not change. Find a better initial grid.
@@ -233,8 +237,8 @@ func TestHiddenSingleError(t *testing.T) {
 	grid := sudoku.NewGrid()
 	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
 
-	changed, err := HiddenSingle(grid)
+	step, err := HiddenSingle(grid)
 	AssertError(t, err)
-	AssertNoChanged(t, changed)
+	AssertNoChanged(t, step)
 	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
 }
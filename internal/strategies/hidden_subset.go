@@ -0,0 +1,98 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+func findHiddenSubsetInSet(set *sudoku.Set, size int) *Step {
+	candidate_digits := []int{}
+	cells_by_digit := map[int][]*sudoku.Cell{}
+
+	for digit := 1; digit <= 9; digit++ {
+		cells := []*sudoku.Cell{}
+		value_found := false
+
+		for _, cell := range set.Cells {
+			if cell.GetValue() == digit {
+				value_found = true
+				break
+			}
+
+			if pencilMarksContainDigit(cell.GetPencilMarks(), digit) {
+				cells = append(cells, cell)
+			}
+		}
+
+		if value_found || len(cells) == 0 || len(cells) > size {
+			continue
+		}
+
+		candidate_digits = append(candidate_digits, digit)
+		cells_by_digit[digit] = cells
+	}
+
+	for _, combination := range getCombinations(len(candidate_digits), size) {
+		digits := []int{}
+		subset_cells := []*sudoku.Cell{}
+
+		for _, index := range combination {
+			digit := candidate_digits[index]
+			digits = append(digits, digit)
+
+			for _, cell := range cells_by_digit[digit] {
+				if !cellsContain(subset_cells, cell) {
+					subset_cells = append(subset_cells, cell)
+				}
+			}
+		}
+
+		if len(subset_cells) != size {
+			continue
+		}
+
+		sortCells(subset_cells)
+
+		step := newStep("Hidden " + subset_names[size])
+		step.Description = fmt.Sprintf("%s can only go in %s in %s", formatDigits(digits), formatCells(subset_cells), set)
+		step.Houses = append(step.Houses, set)
+		step.Cells = append(step.Cells, subset_cells...)
+
+		for _, cell := range subset_cells {
+			for _, pencil_mark := range cell.GetPencilMarks() {
+				if !pencilMarksContainDigit(digits, pencil_mark) {
+					step.eliminate(cell, pencil_mark)
+				}
+			}
+		}
+
+		if step.hasChanges() {
+			return step
+		}
+	}
+
+	return nil
+}
+
+func findHiddenSubset(grid *sudoku.Grid, size int) (*Step, error) {
+	for _, set := range grid.GetSets() {
+		if step := findHiddenSubsetInSet(set, size); step != nil {
+			return step, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func HiddenPair(grid *sudoku.Grid) (*Step, error) {
+	return findHiddenSubset(grid, 2)
+}
+
+func HiddenTriple(grid *sudoku.Grid) (*Step, error) {
+	return findHiddenSubset(grid, 3)
+}
+
+func HiddenQuad(grid *sudoku.Grid) (*Step, error) {
+	return findHiddenSubset(grid, 4)
+}
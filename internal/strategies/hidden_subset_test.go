@@ -0,0 +1,437 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestHiddenPair(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1   3 ||       |       |       ||       |   2 3 | 1 2   ||
+|| 4     |       | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |     9 |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       |       |       ||       |       | 1 2   ||
+||       |     6 |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |     6 ||
+||   8   |   8   |   8   ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 || 1 2   | 1 2   |       ||   2 3 |   2 3 | 1 2   ||
+||       |   5 6 |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7 8 9 |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |   2   ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |   8 9 ||   8   |       |       ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7 8 9 |   8 9 || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |   2   ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |   8 9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1   3 ||       |       |       ||       |   2 3 | 1 2   ||
+|| 4     |       | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |     9 |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       |       |       ||       |       |   2   ||
+||       |     6 |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |     6 ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 || 1 2   | 1 2   |       ||   2 3 |   2 3 | 1 2   ||
+||       |   5 6 |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7 8 9 |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |   2   ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |   8 9 ||   8   |       |       ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7 8 9 |   8 9 || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |   2   ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |   8 9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := HiddenPair(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Hidden Pair: {2, 6} can only go in r2c2, r2c9 in row 2 => r2c2<>8, r2c9<>1, r2c9<>8")
+}
+
+func TestHiddenTriple(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 ||       |       |       ||       |     3 | 1     ||
+|| 4     |  (2)  | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |       |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||       |       |       ||
+||       |  (6)  |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |  (2)  ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2   |       ||     3 |     3 | 1     ||
+||       |   5   |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7     |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |       ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |   8 9 ||   8   |       |       ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7     |   8 9 || 7 8   |     9 |       ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |       ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |       ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 ||       |       |       ||       |     3 | 1     ||
+|| 4     |  (2)  | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |       |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||       |       |       ||
+||       |  (6)  |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |  (2)  ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2   |       ||     3 |     3 | 1     ||
+||       |   5   |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7     |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |       ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |       ||       |       |       ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7     |   8 9 || 7 8   |     9 |       ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |       ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |       ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := HiddenTriple(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Hidden Triple: {1, 2, 3} can only go in r5c3, r5c4, r5c7 in row 5 => r5c3<>8, r5c3<>9, r5c4<>8, r5c7<>8, r5c7<>9")
+}
+
+func TestHiddenQuad(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       | 1     | 1     ||       |       |     3 ||   2   |     3 |   2   ||
+||  (5)  | 4     | 4     ||     6 |  (9)  |       ||     6 |       |       ||
+||       |       |       || 7 8   |       | 7 8   || 7     | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |     3 ||       |     3 |       ||
+||  (7)  |  (6)  |  (8)  ||  (2)  |  (4)  |   5   ||  (1)  |   5   |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (3)  |  (2)  ||   5 6 |     6 |  (1)  ||   5 6 |  (8)  |  (4)  ||
+||       |       |       || 7     | 7     |       || 7     |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       |   2 3 |     3 ||   2   |       |       ||
+||  (4)  |  (8)  |       ||  (9)  |       |   5   ||   5   |  (1)  |  (6)  ||
+||       |       | 7     ||       |       | 7     || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (2)  |  (9)  || 4 5   |       |  (6)  || 4 5   | 4 5   |  (3)  ||
+||       |       |       || 7 8   | 7 8   |       || 7 8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||       |   2 3 |     3 ||   2   |       |   2   ||
+||  (6)  |  (5)  |       ||  (1)  |       | 4     || 4     |  (9)  |       ||
+||       |       | 7     ||       |       | 7 8   || 7 8   |       | 7 8   ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     |       ||       |       |       ||
+||  (2)  | 4     |  (6)  ||  (3)  |       |  (9)  || 4     | 4     |  (5)  ||
+||       |       |       ||       | 7 8   |       || 7 8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1     |       ||       |       | 1     ||
+||  (8)  |  (9)  |  (5)  || 4   6 |     6 | 4     ||  (3)  |  (2)  |       ||
+||       |       |       || 7     | 7     | 7     ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       ||       |       | 1     ||
+||  (3)  |  (7)  | 4     || 4     |  (5)  |  (2)  ||  (9)  |  (6)  |       ||
+||       |       |       ||   8   |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       | 1     | 1     ||       |       |     3 ||   2   |     3 |   2   ||
+||  (5)  | 4     | 4     ||     6 |  (9)  |       ||     6 |       |       ||
+||       |       |       || 7 8   |       | 7 8   || 7     | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |     3 ||       |     3 |       ||
+||  (7)  |  (6)  |  (8)  ||  (2)  |  (4)  |   5   ||  (1)  |   5   |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (3)  |  (2)  ||   5 6 |     6 |  (1)  ||   5 6 |  (8)  |  (4)  ||
+||       |       |       || 7     | 7     |       || 7     |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       |   2 3 |       ||   2   |       |       ||
+||  (4)  |  (8)  |       ||  (9)  |       |   5   ||   5   |  (1)  |  (6)  ||
+||       |       | 7     ||       |       | 7     || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (2)  |  (9)  || 4 5   |       |  (6)  || 4 5   | 4 5   |  (3)  ||
+||       |       |       || 7 8   | 7 8   |       || 7 8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||       |   2 3 |       ||   2   |       |   2   ||
+||  (6)  |  (5)  |       ||  (1)  |       | 4     || 4     |  (9)  |       ||
+||       |       | 7     ||       |       | 7 8   || 7 8   |       | 7 8   ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     |       ||       |       |       ||
+||  (2)  | 4     |  (6)  ||  (3)  |       |  (9)  || 4     | 4     |  (5)  ||
+||       |       |       ||       | 7 8   |       || 7 8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1     |       ||       |       | 1     ||
+||  (8)  |  (9)  |  (5)  || 4   6 |     6 | 4     ||  (3)  |  (2)  |       ||
+||       |       |       || 7     | 7     | 7     ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       ||       |       | 1     ||
+||  (3)  |  (7)  | 4     || 4     |  (5)  |  (2)  ||  (9)  |  (6)  |       ||
+||       |       |       ||   8   |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := HiddenQuad(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Hidden Quad: {4, 5, 7, 8} can only go in r4c6, r5c4, r5c5, r6c6 in box 5 => r4c6<>3, r6c6<>3")
+}
+
+func TestHiddenPairNothingToEliminate(t *testing.T) {
+	// 1 and 9 can only go in r6c2 and r9c2 in column 2, but those cells hold no
+	// other candidate.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (9)  ||  (3)  |       |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |       ||       | 7     |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       | 1     | 1     || 1     |       |       ||
+||     6 |  (4)  |       ||  (5)  |       |     6 ||       |  (2)  |  (9)  ||
+|| 7     |       | 7     ||       | 7 8   |   8   || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||   5 6 |  (2)  |   5   ||     6 |       |  (4)  ||  (3)  |     6 |       ||
+|| 7     |       | 7     ||     9 | 7 8 9 |       ||       | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||     3 |       | 1   3 || 1     | 1 2   | 1 2   ||       |       |       ||
+||       |  (5)  |       ||     6 |       |     6 || 4     | 4     |       ||
+||   8 9 |       | 7 8   ||   8 9 |     9 |     9 || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (6)  |  (2)  ||       |  (4)  |  (3)  ||       |  (1)  |  (5)  ||
+|| 7 8 9 |       |       ||   8 9 |       |       || 7 8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       || 1     |       |       ||       |       |       ||
+||       |       |  (4)  ||       |  (5)  |  (7)  ||  (2)  |  (3)  |  (6)  ||
+||   8 9 |     9 |       ||   8 9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1     | 1     ||       |       |       ||
+||  (2)  |  (7)  |   5   ||  (4)  |       |       ||  (6)  |   5   |  (3)  ||
+||       |       |   8   ||       |   8 9 |   8 9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       || 1     |       | 1     ||
+||  (4)  |  (3)  |       ||  (2)  |  (6)  |  (5)  ||       |       |       ||
+||       |       |   8   ||       |       |       || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       | 1     || 1     |       |       ||
+||   5   |       |  (6)  ||  (7)  |  (3)  |       || 4     | 4 5   |  (2)  ||
+||   8 9 |     9 |       ||       |       |   8   ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := HiddenPair(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestHiddenTripleNothingToEliminate(t *testing.T) {
+	// 6, 8 and 9 can only go in r1c7, r1c9 and r2c8 in box 3, but those cells
+	// hold no other candidate.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||       |     3 |     3 ||       |       |       ||
+||  (2)  |       |  (7)  || 4 5 6 | 4 5   | 4   6 ||     6 |  (1)  |       ||
+||       |     9 |       ||       |     9 |     9 ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     | 1     ||       |       | 1     ||       |       |       ||
+||  (8)  |   5   |   5 6 ||  (7)  |  (2)  |     6 ||  (4)  |     6 |  (3)  ||
+||       |     9 |     9 ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     || 1     | 1   3 |       ||       |       |       ||
+||       |  (4)  |     6 ||     6 |       |  (8)  ||  (2)  |  (5)  |  (7)  ||
+||       |       |     9 ||       |     9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     | 1     ||       |       |       ||
+||  (6)  |       |  (3)  ||  (2)  | 4     | 4     ||       |       |  (5)  ||
+||       |   8 9 |       ||       |     9 |     9 || 7 8 9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   ||       | 1     |       ||       |       |       ||
+||   5   |   5   |   5   ||  (8)  |     6 |  (7)  ||     6 |  (3)  |  (4)  ||
+||       |     9 |     9 ||       |     9 |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |   2   |   2   ||
+||  (7)  |       |  (4)  ||  (3)  |     6 |  (5)  ||  (1)  |     6 |       ||
+||       |   8 9 |       ||       |     9 |       ||       |     9 |   8 9 ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2 3 | 1 2   || 1     |       | 1   3 ||       |       | 1     ||
+|| 4 5   |   5   |   5   || 4 5 6 |  (8)  | 4   6 ||       | 4     |       ||
+||       |       |       ||       |       |       || 7   9 | 7   9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       | 1   3 ||     3 |   2   | 1 2   ||
+||  (9)  |  (6)  |  (8)  || 4 5   |  (7)  | 4     ||   5   | 4     |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     ||       | 1   3 |       ||     3 |       |       ||
+|| 4 5   |  (7)  |   5   ||  (9)  | 4 5   |  (2)  ||   5   |  (8)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := HiddenTriple(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestHiddenQuadNothingToEliminate(t *testing.T) {
+	// 4, 5, 7 and 8 can only go in r1c5, r2c5, r5c5 and r7c5 in column 5, but
+	// those cells hold no other candidate.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |       | 1   3 ||       |       |     3 || 1 2 3 | 1 2 3 | 1 2   ||
+|| 4   6 |  (5)  | 4   6 ||  (7)  | 4     | 4     || 4     | 4     |       ||
+||   8 9 |       |     9 ||       |   8   |     9 ||     9 |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       | 1   3 ||       |       |     3 ||       | 1   3 |       ||
+|| 4     | 4     | 4     ||  (2)  | 4 5   | 4 5   ||  (6)  | 4     |  (7)  ||
+||   8 9 |   8 9 |     9 ||       |   8   |     9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |   2   |     3 ||     3 |       |       ||   2 3 |       |   2   ||
+|| 4     | 4     | 4     ||       |  (1)  |  (6)  || 4     |  (5)  |       ||
+|| 7 8 9 | 7 8 9 |     9 ||   8 9 |       |       ||     9 |       |   8 9 ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       | 1     || 1     | 1     |       ||
+|| 4     | 4     | 4     ||  (6)  |  (2)  |   5   || 4 5   | 4     |  (3)  ||
+||   8 9 |   8 9 |     9 ||       |       | 7   9 || 7   9 | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |   2   |   2   ||
+||  (1)  |  (3)  |  (5)  ||       |       |       || 4     | 4   6 |     6 ||
+||       |       |       ||   8 9 | 7 8   | 7   9 || 7   9 | 7     |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |   2   |       ||       |       | 1     ||       | 1 2   | 1 2   ||
+||     6 |     6 |  (7)  ||  (4)  |  (3)  |   5   ||  (8)  |     6 |   5 6 ||
+||     9 |     9 |       ||       |       |     9 ||       |       |     9 ||
+##=======================##=======================##=======================##
+||     3 |       |       || 1   3 |       |       || 1   3 | 1   3 |       ||
+||   5 6 |     6 |  (2)  ||   5   |   5   |  (8)  ||   5   |     6 |  (4)  ||
+|| 7   9 | 7   9 |       ||       | 7     |       || 7     | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       || 1   3 |       | 1 2 3 || 1 2 3 |       | 1 2   ||
+|| 4 5   | 4     |  (8)  ||   5   |  (6)  | 4 5   ||   5   |  (9)  |   5   ||
+|| 7     | 7     |       ||       |       | 7     || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||     3 |       |   2 3 ||   2 3 |   2 3 |   2   ||
+|| 4 5 6 |  (1)  | 4   6 ||   5   |  (9)  | 4 5   ||   5   |     6 |   5 6 ||
+|| 7     |       |       ||       |       | 7     || 7     | 7 8   |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := HiddenQuad(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
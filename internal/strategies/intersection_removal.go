@@ -0,0 +1,112 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+var intersection_sizes = map[int]string{
+	2: "pair",
+	3: "triple",
+}
+
+func getCommonLine(sets [27]*sudoku.Set, cells []*sudoku.Cell) *sudoku.Set {
+	row := cells[0].GetRowId()
+	column := cells[0].GetColumnId()
+	same_row := true
+	same_column := true
+
+	for _, cell := range cells[1:] {
+		same_row = same_row && cell.GetRowId() == row
+		same_column = same_column && cell.GetColumnId() == column
+	}
+
+	if same_row {
+		return sets[row-1]
+	}
+	if same_column {
+		return sets[column-1+9]
+	}
+
+	return nil
+}
+
+func getCommonBox(sets [27]*sudoku.Set, cells []*sudoku.Cell) *sudoku.Set {
+	box := cells[0].GetBoxId()
+
+	for _, cell := range cells[1:] {
+		if cell.GetBoxId() != box {
+			return nil
+		}
+	}
+
+	return sets[box-1+18]
+}
+
+func findIntersectionRemoval(technique string, source *sudoku.Set, target *sudoku.Set, cells []*sudoku.Cell, digit int) *Step {
+	step := newStep(technique)
+	step.Description = fmt.Sprintf("%d in %s is confined to %s (%s %s)", digit, source, target, intersection_sizes[len(cells)], formatCells(cells))
+	step.Houses = append(step.Houses, source, target)
+	step.Cells = append(step.Cells, cells...)
+
+	for _, cell := range target.Cells {
+		if !cellsContain(cells, cell) {
+			step.eliminate(cell, digit)
+		}
+	}
+
+	if !step.hasChanges() {
+		return nil
+	}
+
+	return step
+}
+
+func Pointing(grid *sudoku.Grid) (*Step, error) {
+	sets := grid.GetSets()
+
+	for _, box := range sets[18:] {
+		for digit := 1; digit <= 9; digit++ {
+			cells := getCellsWithPencilMark(box.Cells[:], digit)
+			if len(cells) < 2 || len(cells) > 3 {
+				continue
+			}
+
+			line := getCommonLine(sets, cells)
+			if line == nil {
+				continue
+			}
+
+			if step := findIntersectionRemoval("Pointing", box, line, cells, digit); step != nil {
+				return step, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func BoxLineReduction(grid *sudoku.Grid) (*Step, error) {
+	sets := grid.GetSets()
+
+	for _, line := range sets[:18] {
+		for digit := 1; digit <= 9; digit++ {
+			cells := getCellsWithPencilMark(line.Cells[:], digit)
+			if len(cells) < 2 || len(cells) > 3 {
+				continue
+			}
+
+			box := getCommonBox(sets, cells)
+			if box == nil {
+				continue
+			}
+
+			if step := findIntersectionRemoval("Box/Line Reduction", line, box, cells, digit); step != nil {
+				return step, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,292 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestPointing(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1   3 ||       |       |       ||       |   2 3 | 1 2   ||
+|| 4     |       | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |     9 |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       |       |       ||       |       | 1 2   ||
+||       |     6 |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |     6 ||
+||   8   |   8   |   8   ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 || 1 2   | 1 2   |       ||   2 3 |   2 3 | 1 2   ||
+||       |   5 6 |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7 8 9 |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |   2   ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |   8 9 ||   8   |       |       ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7 8 9 |   8 9 || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |   2   ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |   8 9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1   3 ||       |       |       ||       |   2 3 | 1 2   ||
+|| 4     |       | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |     9 |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       |       |       ||       |       | 1 2   ||
+||       |     6 |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |     6 ||
+||   8   |   8   |   8   ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |   2   |     3 || 1 2   | 1 2   |       ||   2 3 |   2 3 |   2   ||
+||       |   5 6 |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7 8 9 |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |   2   ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |   8 9 ||   8   |       |       ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7 8 9 |   8 9 || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |   2   ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |   8 9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Pointing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Pointing: 1 in box 2 is confined to row 3 (pair r3c4, r3c5) => r3c1<>1, r3c3<>1, r3c9<>1")
+}
+
+func TestBoxLineReduction(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |   2 3 |   2 3 ||   2 3 |       |       ||       |       |     3 ||
+||   5   | 4 5 6 | 4 5   ||     6 |  (1)  |  (9)  ||  (8)  |  (7)  |   5 6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |   2 3 |       ||   2 3 |     3 |   2 3 ||       |       |     3 ||
+||   5   |   5 6 |  (9)  ||     6 |     6 |     6 ||  (4)  |  (1)  |   5 6 ||
+|| 7     | 7     |       ||   8   |   8   |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       |       ||       |     3 |       ||
+||  (8)  |     6 |  (1)  ||  (5)  |  (4)  |  (7)  ||     6 |       |  (2)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |   2 3 |   2 3 ||     3 |     3 |     3 ||       |   2 3 |     3 ||
+||  (1)  | 4 5   | 4 5   ||     6 |     6 | 4   6 ||  (7)  |   5   | 4   6 ||
+||       |     9 |       ||   8   |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |     3 ||
+||   5   | 4 5   |  (6)  ||  (7)  |  (2)  |  (1)  ||   5   |  (8)  | 4     ||
+||       |     9 |       ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |   2 3 |       ||       |       |     3 ||   2   |   2 3 |       ||
+||       | 4     |  (8)  ||  (9)  |  (5)  | 4   6 ||     6 |       |  (1)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||   2 3 |       |   2 3 ||       |     3 |   2 3 ||   2   |       |       ||
+||   5 6 |  (8)  |   5   ||  (1)  |     6 |     6 ||   5   |  (4)  |  (7)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2 3 |   2 3 ||   2 3 |     3 |       ||       |       |       ||
+||  (4)  |       |       ||       |       |  (5)  ||  (1)  |  (6)  |  (9)  ||
+||       | 7     | 7     ||   8   | 7 8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |   2   ||       |       |   2   ||       |   2   |       ||
+||   5 6 |  (1)  |   5   ||  (4)  |     6 |     6 ||  (3)  |   5   |  (8)  ||
+|| 7   9 |       | 7     ||       | 7   9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |   2 3 |   2 3 ||   2 3 |       |       ||       |       |     3 ||
+||   5   | 4 5 6 | 4 5   ||     6 |  (1)  |  (9)  ||  (8)  |  (7)  |   5 6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |   2 3 |       ||   2 3 |     3 |   2 3 ||       |       |     3 ||
+||   5   |   5 6 |  (9)  ||     6 |     6 |     6 ||  (4)  |  (1)  |   5 6 ||
+|| 7     | 7     |       ||   8   |   8   |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       |       ||       |     3 |       ||
+||  (8)  |     6 |  (1)  ||  (5)  |  (4)  |  (7)  ||     6 |       |  (2)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |   2 3 |   2 3 ||     3 |     3 |     3 ||       |   2 3 |     3 ||
+||  (1)  | 4 5   | 4 5   ||     6 |     6 | 4   6 ||  (7)  |   5   | 4   6 ||
+||       |     9 |       ||   8   |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |     3 ||
+||   5   | 4 5   |  (6)  ||  (7)  |  (2)  |  (1)  ||   5   |  (8)  | 4     ||
+||       |     9 |       ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |   2 3 |       ||       |       |     3 ||   2   |   2 3 |       ||
+||       | 4     |  (8)  ||  (9)  |  (5)  | 4   6 ||     6 |       |  (1)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||   2 3 |       |   2 3 ||       |     3 |   2 3 ||   2   |       |       ||
+||   5 6 |  (8)  |   5   ||  (1)  |     6 |     6 ||   5   |  (4)  |  (7)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2 3 |   2 3 ||   2 3 |     3 |       ||       |       |       ||
+||  (4)  |       |       ||       |       |  (5)  ||  (1)  |  (6)  |  (9)  ||
+||       |       | 7     ||   8   | 7 8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |   2   ||       |       |   2   ||       |   2   |       ||
+||   5 6 |  (1)  |   5   ||  (4)  |     6 |     6 ||  (3)  |   5   |  (8)  ||
+||     9 |       | 7     ||       | 7   9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := BoxLineReduction(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Box/Line Reduction: 7 in column 3 is confined to box 7 (pair r8c3, r9c3) => r8c2<>7, r9c1<>7")
+}
+
+func TestPointingNothingToEliminate(t *testing.T) {
+	// 1 in box 2 is confined to column 5, but no other cell of column 5 holds 1.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       | 1     |       || 1     |       |       ||
+||  (7)  |  (3)  |  (2)  ||  (8)  |       | 4     || 4     |  (5)  |  (6)  ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (5)  |  (7)  |  (6)  ||  (9)  |  (3)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2   |   2   ||       |       | 1     ||
+||  (6)  |  (9)  |  (5)  ||  (3)  |       | 4     ||  (8)  |  (7)  | 4     ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (7)  |  (9)  ||  (1)  |  (4)  |  (8)  ||  (3)  |  (6)  |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |   2   |       ||
+||  (4)  |  (1)  |  (3)  ||       |  (6)  |  (5)  ||  (7)  |       |  (8)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       || 1 2   | 1 2   | 1     ||
+||  (5)  |  (8)  |  (6)  ||  (7)  |       |  (3)  || 4     | 4     | 4     ||
+||       |       |       ||       |     9 |       ||       |     9 |     9 ||
+##=======================##=======================##=======================##
+||       |       |       ||   2   |       |       ||   2   |   2   |       ||
+||  (1)  |  (6)  |  (8)  ||       |  (5)  |  (7)  || 4     | 4     |  (3)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       | 1 2   | 1     ||
+||  (3)  |  (5)  |  (7)  ||  (4)  |  (8)  |       ||  (6)  |       |       ||
+||       |       |       ||       |       |     9 ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (2)  |  (4)  ||  (6)  |  (3)  |  (1)  ||  (5)  |  (8)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Pointing(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestBoxLineReductionNothingToEliminate(t *testing.T) {
+	// 9 in row 1 is confined to box 2, but no other cell of box 2 holds 9.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       | 1     |       || 1     |       |       ||
+||  (7)  |  (3)  |  (2)  ||  (8)  |       | 4     || 4     |  (5)  |  (6)  ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (5)  |  (7)  |  (6)  ||  (9)  |  (3)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2   |   2   ||       |       | 1     ||
+||  (6)  |  (9)  |  (5)  ||  (3)  |       | 4     ||  (8)  |  (7)  | 4     ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (7)  |  (9)  ||  (1)  |  (4)  |  (8)  ||  (3)  |  (6)  |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |   2   |       ||
+||  (4)  |  (1)  |  (3)  ||       |  (6)  |  (5)  ||  (7)  |       |  (8)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       || 1 2   | 1 2   | 1     ||
+||  (5)  |  (8)  |  (6)  ||  (7)  |       |  (3)  || 4     | 4     | 4     ||
+||       |       |       ||       |     9 |       ||       |     9 |     9 ||
+##=======================##=======================##=======================##
+||       |       |       ||   2   |       |       ||   2   |   2   |       ||
+||  (1)  |  (6)  |  (8)  ||       |  (5)  |  (7)  || 4     | 4     |  (3)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       | 1 2   | 1     ||
+||  (3)  |  (5)  |  (7)  ||  (4)  |  (8)  |       ||  (6)  |       |       ||
+||       |       |       ||       |       |     9 ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (2)  |  (4)  ||  (6)  |  (3)  |  (1)  ||  (5)  |  (8)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := BoxLineReduction(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
@@ -0,0 +1,231 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+type medusaCluster struct {
+	colours    map[candidate]int
+	candidates [2][]candidate
+}
+
+func getMedusaLinks(grid *sudoku.Grid, sets [27]*sudoku.Set) map[candidate][]candidate {
+	links := map[candidate][]candidate{}
+
+	for digit := 1; digit <= 9; digit++ {
+		for _, conjugate_pair := range getConjugatePairs(sets, digit) {
+			node := candidate{conjugate_pair[0], digit}
+			other_node := candidate{conjugate_pair[1], digit}
+			links[node] = append(links[node], other_node)
+			links[other_node] = append(links[other_node], node)
+		}
+	}
+
+	for _, cell := range grid.GetAllCells() {
+		pencil_marks := cell.GetPencilMarks()
+		if cell.GetValue() != sudoku.Empty || len(pencil_marks) != 2 {
+			continue
+		}
+
+		node := candidate{cell, pencil_marks[0]}
+		other_node := candidate{cell, pencil_marks[1]}
+		links[node] = append(links[node], other_node)
+		links[other_node] = append(links[other_node], node)
+	}
+
+	return links
+}
+
+func getMedusaClusters(grid *sudoku.Grid, sets [27]*sudoku.Set) []*medusaCluster {
+	links := getMedusaLinks(grid, sets)
+
+	nodes := []candidate{}
+	for node := range links {
+		nodes = append(nodes, node)
+	}
+	sortCandidates(nodes)
+
+	clusters := []*medusaCluster{}
+	coloured := map[candidate]bool{}
+
+	for _, start := range nodes {
+		if coloured[start] {
+			continue
+		}
+
+		cluster := &medusaCluster{map[candidate]int{start: 0}, [2][]candidate{}}
+		coloured[start] = true
+		queue := []candidate{start}
+
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+
+			for _, linked_node := range links[node] {
+				if coloured[linked_node] {
+					continue
+				}
+
+				coloured[linked_node] = true
+				cluster.colours[linked_node] = 1 - cluster.colours[node]
+				queue = append(queue, linked_node)
+			}
+		}
+
+		if len(cluster.colours) < 3 {
+			continue
+		}
+
+		for node, colour := range cluster.colours {
+			cluster.candidates[colour] = append(cluster.candidates[colour], node)
+		}
+		sortCandidates(cluster.candidates[0])
+		sortCandidates(cluster.candidates[1])
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters
+}
+
+func (c *medusaCluster) String() string {
+	return fmt.Sprintf("coloured A: %s, B: %s", formatCandidates(c.candidates[0]), formatCandidates(c.candidates[1]))
+}
+
+func (c *medusaCluster) getColour(cell *sudoku.Cell, digit int) (int, bool) {
+	colour, found := c.colours[candidate{cell, digit}]
+	return colour, found
+}
+
+// seesColour reports whether the candidate sees a candidate of the same digit
+// with the given colour in another cell.
+func (c *medusaCluster) seesColour(cell *sudoku.Cell, digit int, colour int) bool {
+	for _, node := range c.candidates[colour] {
+		if node.digit == digit && cellsSeeEachOther(cell, node.cell) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *medusaCluster) newStep(reason string) *Step {
+	step := newStep("3D Medusa")
+	step.Description = fmt.Sprintf("%s; %s", c, reason)
+
+	for _, candidates := range c.candidates {
+		for _, node := range candidates {
+			if !cellsContain(step.Cells, node.cell) {
+				step.Cells = append(step.Cells, node.cell)
+			}
+		}
+	}
+
+	return step
+}
+
+func (c *medusaCluster) eliminateColour(colour int, reason string) *Step {
+	step := c.newStep(reason)
+
+	for _, node := range c.candidates[colour] {
+		step.eliminate(node.cell, node.digit)
+	}
+
+	return step
+}
+
+func (c *medusaCluster) findFalseColour(grid *sudoku.Grid) *Step {
+	names := [2]string{"A", "B"}
+
+	for colour := 0; colour < 2; colour++ {
+		for _, combination := range getCombinations(len(c.candidates[colour]), 2) {
+			node := c.candidates[colour][combination[0]]
+			other_node := c.candidates[colour][combination[1]]
+
+			if node.cell == other_node.cell {
+				return c.eliminateColour(colour, fmt.Sprintf("%s and %s are both coloured %s in the same cell", node, other_node, names[colour]))
+			}
+
+			if node.digit == other_node.digit && cellsSeeEachOther(node.cell, other_node.cell) {
+				return c.eliminateColour(colour, fmt.Sprintf("%s and %s are both coloured %s in the same house", node, other_node, names[colour]))
+			}
+		}
+	}
+
+	for _, cell := range grid.GetAllCells() {
+		if cell.GetValue() != sudoku.Empty {
+			continue
+		}
+
+		for colour := 0; colour < 2; colour++ {
+			emptied := true
+
+			for _, digit := range cell.GetPencilMarks() {
+				if _, found := c.getColour(cell, digit); found || !c.seesColour(cell, digit, colour) {
+					emptied = false
+					break
+				}
+			}
+
+			if emptied {
+				return c.eliminateColour(colour, fmt.Sprintf("%s would be emptied by colour %s", cell, names[colour]))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *medusaCluster) findUncolouredEliminations(grid *sudoku.Grid) *Step {
+	step := c.newStep("uncoloured candidates seeing both colours are eliminated")
+
+	for _, cell := range grid.GetAllCells() {
+		if cell.GetValue() != sudoku.Empty {
+			continue
+		}
+
+		cell_colours := [2]bool{}
+		for _, digit := range cell.GetPencilMarks() {
+			if colour, found := c.getColour(cell, digit); found {
+				cell_colours[colour] = true
+			}
+		}
+
+		for _, digit := range cell.GetPencilMarks() {
+			if _, found := c.getColour(cell, digit); found {
+				continue
+			}
+
+			sees := [2]bool{c.seesColour(cell, digit, 0), c.seesColour(cell, digit, 1)}
+
+			if (cell_colours[0] && cell_colours[1]) ||
+				(sees[0] && sees[1]) ||
+				(cell_colours[0] && sees[1]) ||
+				(cell_colours[1] && sees[0]) {
+				step.eliminate(cell, digit)
+			}
+		}
+	}
+
+	if !step.hasChanges() {
+		return nil
+	}
+
+	return step
+}
+
+func Medusa(grid *sudoku.Grid) (*Step, error) {
+	for _, cluster := range getMedusaClusters(grid, grid.GetSets()) {
+		if step := cluster.findFalseColour(grid); step != nil {
+			return step, nil
+		}
+
+		if step := cluster.findUncolouredEliminations(grid); step != nil {
+			return step, nil
+		}
+	}
+
+	return nil, nil
+}
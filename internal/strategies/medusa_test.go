@@ -0,0 +1,425 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestMedusaTwiceInCell(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |  (7)  |  (4)  ||     6 |  (1)  |     6 ||   5   |   5   |  (2)  ||
+||       |       |       ||     9 |       |     9 ||   8   |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (6)  ||  (4)  |   5   |   5   ||  (7)  |  (3)  |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (5)  |  (2)  ||  (3)  |  (7)  |  (8)  ||  (6)  |  (1)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |     3 | 1     ||       |       | 1   3 ||
+||  (5)  |     6 |  (7)  ||       |     6 |       ||  (4)  |  (2)  |     6 ||
+||       |     9 |       ||   8 9 |   8   |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |     3 ||     3 |       |       ||
+||  (4)  |  (1)  |  (8)  ||  (2)  |   5 6 |   5 6 ||   5   |   5 6 |  (7)  ||
+||       |       |       ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       | 1     || 1     |       | 1     ||
+||  (2)  |     6 |  (3)  ||  (7)  |  (4)  |   5   ||   5   |   5 6 |     6 ||
+||       |     9 |       ||       |       |     9 ||   8   |   8 9 |   8   ||
+##=======================##=======================##=======================##
+||       |   2   |       ||       |   2 3 |   2 3 ||       |       |     3 ||
+||  (8)  | 4     |  (5)  ||  (1)  |     6 | 4     ||  (9)  |  (7)  |     6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||       |       |   2 3 ||   2 3 |       |       ||
+||  (7)  | 4     |  (1)  ||     6 |  (9)  | 4     ||       |     6 |  (5)  ||
+||       |       |       ||   8   |       |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       || 1 2   |       | 1     ||
+||  (6)  |  (3)  |  (9)  ||  (5)  |       |  (7)  ||       |  (4)  |       ||
+||       |       |       ||       |   8   |       ||   8   |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |  (7)  |  (4)  ||       |  (1)  |     6 ||   5   |   5   |  (2)  ||
+||       |       |       ||     9 |       |       ||   8   |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (6)  ||  (4)  |   5   |   5   ||  (7)  |  (3)  |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (5)  |  (2)  ||  (3)  |  (7)  |  (8)  ||  (6)  |  (1)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       | 1     ||       |       | 1   3 ||
+||  (5)  |     6 |  (7)  ||       |     6 |       ||  (4)  |  (2)  |     6 ||
+||       |     9 |       ||   8   |       |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |     3 ||       |       |       ||
+||  (4)  |  (1)  |  (8)  ||  (2)  |   5 6 |   5   ||   5   |   5 6 |  (7)  ||
+||       |       |       ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       | 1     || 1     |       | 1     ||
+||  (2)  |     6 |  (3)  ||  (7)  |  (4)  |   5   ||   5   |   5 6 |     6 ||
+||       |     9 |       ||       |       |     9 ||   8   |   8 9 |   8   ||
+##=======================##=======================##=======================##
+||       |   2   |       ||       |   2 3 |   2 3 ||       |       |       ||
+||  (8)  | 4     |  (5)  ||  (1)  |       | 4     ||  (9)  |  (7)  |     6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||       |       |   2   ||     3 |       |       ||
+||  (7)  | 4     |  (1)  ||     6 |  (9)  | 4     ||       |       |  (5)  ||
+||       |       |       ||       |       |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       || 1 2   |       | 1     ||
+||  (6)  |  (3)  |  (9)  ||  (5)  |       |  (7)  ||       |  (4)  |       ||
+||       |       |       ||       |   8   |       ||   8   |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Medusa(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "3D Medusa: coloured A: r1c4(6), r1c6(9), r4c4(9), r4c5(3), r4c5(8), r5c6(6), r5c7(3), r7c5(6), r7c9(3), r8c4(8), r8c6(3), r8c7(2), r8c8(6), r9c5(2), B: r1c4(9), r1c6(6), r4c4(8), r4c9(3), r5c7(5), r7c9(6), r8c4(6), r8c7(3), r8c8(8), r9c5(8), r9c7(2); r4c5(3) and r4c5(8) are both coloured A in the same cell => r1c4<>6, r1c6<>9, r4c4<>9, r4c5<>3, r4c5<>8, r5c6<>6, r5c7<>3, r7c5<>6, r7c9<>3, r8c4<>8, r8c6<>3, r8c7<>2, r8c8<>6, r9c5<>2")
+}
+
+func TestMedusaTwiceInHouse(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |     3 |       ||   2 3 |       |       ||       |       |     3 ||
+||   5   |   5 6 |  (4)  ||     6 |  (1)  |  (9)  ||  (8)  |  (7)  |   5 6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |     3 |       ||   2 3 |     3 |     3 ||       |       |     3 ||
+||   5   |   5 6 |  (9)  ||     6 |     6 |     6 ||  (4)  |  (1)  |   5 6 ||
+|| 7     | 7     |       ||   8   |   8   |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       |       ||       |     3 |       ||
+||  (8)  |     6 |  (1)  ||  (5)  |  (4)  |  (7)  ||     6 |       |  (2)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |     3 ||       |       |     3 ||
+||  (1)  |   5   |  (2)  ||     6 |     6 | 4   6 ||  (7)  |   5   | 4   6 ||
+||       |     9 |       ||   8   |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |     3 ||
+||   5   | 4 5   |  (6)  ||  (7)  |  (2)  |  (1)  ||   5   |  (8)  | 4     ||
+||       |     9 |       ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |     3 ||   2   |   2 3 |       ||
+||       | 4     |  (8)  ||  (9)  |  (5)  | 4   6 ||     6 |       |  (1)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       |     3 |   2 3 ||   2   |       |       ||
+||     6 |  (8)  |   5   ||  (1)  |     6 |     6 ||   5   |  (4)  |  (7)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |     3 |       ||       |       |       ||
+||  (4)  |  (2)  |       ||       |       |  (5)  ||  (1)  |  (6)  |  (9)  ||
+||       |       | 7     ||   8   | 7 8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |   2   |       ||
+||     6 |  (1)  |   5   ||  (4)  |     6 |     6 ||  (3)  |   5   |  (8)  ||
+||     9 |       | 7     ||       | 7   9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |     3 |       ||   2 3 |       |       ||       |       |     3 ||
+||   5   |   5 6 |  (4)  ||     6 |  (1)  |  (9)  ||  (8)  |  (7)  |   5 6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |     3 |       ||   2 3 |     3 |     3 ||       |       |     3 ||
+||   5   |   5 6 |  (9)  ||     6 |     6 |     6 ||  (4)  |  (1)  |   5 6 ||
+|| 7     | 7     |       ||   8   |   8   |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |     3 |       ||
+||  (8)  |     6 |  (1)  ||  (5)  |  (4)  |  (7)  ||       |       |  (2)  ||
+||       |       |       ||       |       |       ||     9 |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |     3 ||       |       |     3 ||
+||  (1)  |   5   |  (2)  ||     6 |     6 | 4   6 ||  (7)  |       | 4     ||
+||       |       |       ||   8   |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |     3 ||
+||   5   | 4 5   |  (6)  ||  (7)  |  (2)  |  (1)  ||   5   |  (8)  | 4     ||
+||       |     9 |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |     3 ||       |   2   |       ||
+||       | 4     |  (8)  ||  (9)  |  (5)  | 4     ||     6 |       |  (1)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |     3 |     3 ||   2   |       |       ||
+||     6 |  (8)  |   5   ||  (1)  |     6 |     6 ||       |  (4)  |  (7)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |     3 |       ||       |       |       ||
+||  (4)  |  (2)  |       ||       |       |  (5)  ||  (1)  |  (6)  |  (9)  ||
+||       |       |       ||   8   | 7 8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |       |       ||
+||     6 |  (1)  |       ||  (4)  |     6 |       ||  (3)  |   5   |  (8)  ||
+||     9 |       | 7     ||       |     9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Medusa(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "3D Medusa: coloured A: r3c2(3), r3c7(6), r3c8(9), r4c2(9), r4c8(5), r4c9(6), r5c7(9), r6c6(6), r6c7(2), r6c8(3), r7c3(3), r7c6(2), r7c7(5), r8c3(7), r9c3(5), r9c5(7), r9c6(6), r9c8(2), B: r3c2(6), r3c7(9), r3c8(3), r4c2(5), r4c8(9), r5c2(9), r5c7(5), r6c7(6), r6c8(2), r7c3(5), r7c7(2), r8c3(3), r8c5(7), r9c3(7), r9c6(2), r9c8(5); r6c6(6) and r9c6(6) are both coloured A in the same house => r3c2<>3, r3c7<>6, r3c8<>9, r4c2<>9, r4c8<>5, r4c9<>6, r5c7<>9, r6c6<>6, r6c7<>2, r6c8<>3, r7c3<>3, r7c6<>2, r7c7<>5, r8c3<>7, r9c3<>5, r9c5<>7, r9c6<>6, r9c8<>2")
+}
+
+func TestMedusaCellEmptiedByColour(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (6)  |  (2)  |  (5)  ||  (9)  |  (7)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (6)  ||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||   5 6 |   5 6 |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |   2   || 1 2   |       |   2   || 1     | 1 2   |       ||
+||  (9)  |  (3)  |       ||       |  (6)  | 4     ||       | 4     |  (5)  ||
+||       |       |   8   ||   8   |       | 7     || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1 2   |     3 |       || 1   3 | 1 2 3 |       ||
+||  (5)  |  (7)  |       ||       | 4     |  (9)  ||       | 4     |  (6)  ||
+||       |       |   8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |   2   ||     3 |   2 3 |       ||
+||  (1)  |  (6)  |  (4)  ||  (5)  |       |       ||       |       |       ||
+||       |       |       ||       | 7     | 7 8   || 7 8   |     9 | 7   9 ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       ||       | 1     |       ||
+||  (6)  |       |       ||  (3)  |  (5)  |       ||  (2)  |       |  (4)  ||
+||       |   8   | 7   9 ||       |       | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   |       |   2   || 1     | 1     |       ||
+||  (3)  |       |       ||       | 4     | 4   6 ||   5 6 |   5 6 |  (8)  ||
+||       |       | 7   9 ||     9 | 7     | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||   2   |       |       ||     3 |     3 |       ||
+||  (4)  |       |  (5)  ||       |  (1)  |     6 ||     6 |     6 |       ||
+||       |   8   |       ||   8 9 |       |   8   || 7     |     9 | 7   9 ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (6)  |  (2)  |  (5)  ||  (9)  |  (7)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (6)  ||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||   5 6 |   5 6 |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |   2   || 1 2   |       |   2   || 1     | 1 2   |       ||
+||  (9)  |  (3)  |       ||       |  (6)  | 4     ||       | 4     |  (5)  ||
+||       |       |   8   ||   8   |       | 7     || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1 2   |     3 |       || 1   3 | 1 2 3 |       ||
+||  (5)  |  (7)  |       ||       | 4     |  (9)  ||       | 4     |  (6)  ||
+||       |       |   8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |   2   ||     3 |   2 3 |       ||
+||  (1)  |  (6)  |  (4)  ||  (5)  |       |       ||       |       |       ||
+||       |       |       ||       | 7     | 7 8   || 7 8   |     9 | 7   9 ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       | 1     |       ||
+||  (6)  |       |       ||  (3)  |  (5)  |       ||  (2)  |       |  (4)  ||
+||       |   8   |     9 ||       |       | 7     ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||   2   |       |   2   || 1     | 1     |       ||
+||  (3)  |       |       ||       | 4     | 4   6 ||   5 6 |   5 6 |  (8)  ||
+||       |       | 7     ||     9 | 7     | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||       |       |       ||     3 |     3 |       ||
+||  (4)  |       |  (5)  ||       |  (1)  |     6 ||     6 |     6 |       ||
+||       |       |       ||   8 9 |       |   8   || 7     |     9 | 7   9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Medusa(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "3D Medusa: coloured A: r7c2(1), r7c3(7), r7c6(8), r7c8(9), r8c2(2), r8c3(9), r9c2(8), r9c4(2), B: r7c2(8), r7c3(9), r7c6(7), r7c8(1), r8c2(1), r8c3(7), r9c2(2); r8c4 would be emptied by colour A => r7c2<>1, r7c3<>7, r7c6<>8, r7c8<>9, r8c2<>2, r8c3<>9, r9c2<>8, r9c4<>2")
+}
+
+func TestMedusaUncolouredCandidates(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       | 1   3 |     3 ||       |       |     3 || 1     |       |       ||
+||  (7)  |   5 6 |   5 6 ||  (4)  |  (2)  |   5 6 ||   5   |  (8)  |   5   ||
+||       |       |       ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |       |     3 ||       |       |       ||
+||   5   |  (8)  |  (4)  ||  (9)  |  (1)  |   5   ||  (7)  |  (6)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       |       || 1     |       |       ||
+||  (9)  |   5 6 |  (2)  ||   5 6 |   5 6 |  (7)  ||   5   |  (3)  |  (4)  ||
+||       |       |       ||   8   |   8   |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+|| 4   6 |   5   |  (1)  ||  (7)  |   5 6 |   5 6 ||  (2)  | 4     |  (3)  ||
+||       |     9 |       ||       |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |     3 ||       |       |       ||       |       |       ||
+||  (8)  |       |       ||  (2)  |  (4)  |  (9)  ||  (6)  |  (5)  |  (1)  ||
+||       | 7     | 7     ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+|| 4   6 |  (2)  |   5   ||  (3)  |   5 6 |  (1)  || 4     |  (7)  |  (8)  ||
+||       |       |     9 ||       |       |       ||     9 |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |     6 |     6 ||   5   |  (3)  | 4 5   || 4 5   |  (1)  |  (7)  ||
+||       |     9 |     9 ||   8   |       |   8   ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |   2   |       ||
+||  (1)  |  (4)  |  (8)  ||   5 6 |  (7)  |   5 6 ||  (3)  |       |   5   ||
+||       |       |       ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |     3 ||       |       |   2   ||       |   2   |       ||
+||   5   |   5   |   5   ||  (1)  |  (9)  | 4     || 4     | 4     |  (6)  ||
+||       | 7     | 7     ||       |       |   8   ||   8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       | 1   3 |     3 ||       |       |     3 || 1     |       |       ||
+||  (7)  |   5 6 |     6 ||  (4)  |  (2)  |   5 6 ||   5   |  (8)  |   5   ||
+||       |       |       ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |       |     3 ||       |       |       ||
+||   5   |  (8)  |  (4)  ||  (9)  |  (1)  |   5   ||  (7)  |  (6)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       |       || 1     |       |       ||
+||  (9)  |   5 6 |  (2)  ||   5 6 |   5 6 |  (7)  ||   5   |  (3)  |  (4)  ||
+||       |       |       ||   8   |   8   |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+|| 4   6 |   5   |  (1)  ||  (7)  |   5 6 |   5 6 ||  (2)  | 4     |  (3)  ||
+||       |     9 |       ||       |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |     3 ||       |       |       ||       |       |       ||
+||  (8)  |       |       ||  (2)  |  (4)  |  (9)  ||  (6)  |  (5)  |  (1)  ||
+||       | 7     | 7     ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+|| 4   6 |  (2)  |   5   ||  (3)  |   5 6 |  (1)  || 4     |  (7)  |  (8)  ||
+||       |       |     9 ||       |       |       ||     9 |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |     6 |     6 ||   5   |  (3)  | 4 5   || 4 5   |  (1)  |  (7)  ||
+||       |     9 |     9 ||   8   |       |   8   ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |   2   |       ||
+||  (1)  |  (4)  |  (8)  ||   5 6 |  (7)  |   5 6 ||  (3)  |       |   5   ||
+||       |       |       ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |     3 ||       |       |   2   ||       |   2   |       ||
+||   5   |   5   |   5   ||  (1)  |  (9)  | 4     || 4     | 4     |  (6)  ||
+||       | 7     | 7     ||       |       |   8   ||   8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Medusa(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "3D Medusa: coloured A: r1c3(6), r1c9(9), r4c1(6), r4c2(9), r4c8(4), r6c1(4), r6c3(5), r6c5(6), r6c7(9), r7c2(6), r7c3(9), r8c6(2), r8c8(9), r8c9(5), r9c8(2), B: r1c7(9), r1c9(5), r4c1(4), r4c2(5), r4c8(9), r6c1(6), r6c3(9), r6c5(5), r6c7(4), r7c2(9), r7c3(6), r7c7(5), r8c8(2), r8c9(9), r9c6(2), r9c8(4); uncoloured candidates seeing both colours are eliminated => r1c3<>5")
+}
+
+func TestMedusaNothingToEliminate(t *testing.T) {
+	// The cluster is coloured A: r1c4(9), r1c8(6), r3c5(1), r3c8(9), r8c5(9) and
+	// B: r1c8(9), r3c5(9), r8c4(9), but neither colour contradicts itself and no
+	// uncoloured candidate sees both colours.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1 2 3 ||       |       | 1 2 3 ||   2   |   2   | 1 2   ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+|| 7 8   |       | 7 8   ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2 3 | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Medusa(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/alltilla/sudoku-solver/internal/sudoku"
 )
 
-func NakedSingle(grid *sudoku.Grid) (bool, error) {
+func NakedSingle(grid *sudoku.Grid) (*Step, error) {
 	for _, cell := range grid.GetAllCells() {
 		if cell.GetValue() != sudoku.Empty {
 			continue
@@ -15,14 +15,17 @@ func NakedSingle(grid *sudoku.Grid) (bool, error) {
 		pencil_marks := cell.GetPencilMarks()
 
 		if len(pencil_marks) == 1 {
-			cell.SetValue(pencil_marks[0])
-			return true, nil
+			step := newStep("Naked Single")
+			step.Description = fmt.Sprintf("%d is the only candidate left in %s", pencil_marks[0], cell)
+			step.Cells = append(step.Cells, cell)
+			step.place(cell, pencil_marks[0])
+			return step, nil
 		}
 
 		if len(pencil_marks) == 0 {
-			return false, fmt.Errorf("no possible value for cell (%d, %d)", cell.GetRowId(), cell.GetColumnId())
+			return nil, fmt.Errorf("no possible value for cell (%d, %d)", cell.GetRowId(), cell.GetColumnId())
 		}
 	}
 
-	return false, nil
+	return nil, nil
 }
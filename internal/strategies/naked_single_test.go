@@ -131,19 +131,19 @@ func TestNakedSingle(t *testing.T) {
 	grid := sudoku.NewGrid()
 	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
 
-	changed, err := NakedSingle(grid)
+	step, err := NakedSingle(grid)
 	AssertNoError(t, err)
-	AssertChanged(t, changed)
+	AssertChanged(t, step)
 	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str_after_first_call)
 
-	changed, err = NakedSingle(grid)
+	step, err = NakedSingle(grid)
 	AssertNoError(t, err)
-	AssertChanged(t, changed)
+	AssertChanged(t, step)
 	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str_after_second_call)
 
-	changed, err = NakedSingle(grid)
+	step, err = NakedSingle(grid)
 	AssertNoError(t, err)
-	AssertNoChanged(t, changed)
+	AssertNoChanged(t, step)
 	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str_after_second_call)
 }
 
@@ -191,8 +191,8 @@ func TestNakedSinglesError(t *testing.T) {
 	grid := sudoku.NewGrid()
 	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
 
-	changed, err := NakedSingle(grid)
+	step, err := NakedSingle(grid)
 	AssertError(t, err)
-	AssertNoChanged(t, changed)
+	AssertNoChanged(t, step)
 	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
 }
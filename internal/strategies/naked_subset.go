@@ -0,0 +1,85 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+var subset_names = map[int]string{
+	2: "Pair",
+	3: "Triple",
+	4: "Quad",
+}
+
+func findNakedSubsetInSet(set *sudoku.Set, size int) *Step {
+	candidate_cells := []*sudoku.Cell{}
+	for _, cell := range set.Cells {
+		if cell.GetValue() != sudoku.Empty {
+			continue
+		}
+
+		pencil_mark_count := len(cell.GetPencilMarks())
+		if pencil_mark_count >= 2 && pencil_mark_count <= size {
+			candidate_cells = append(candidate_cells, cell)
+		}
+	}
+
+	for _, combination := range getCombinations(len(candidate_cells), size) {
+		subset_cells := []*sudoku.Cell{}
+		mask := 0
+		for _, index := range combination {
+			subset_cells = append(subset_cells, candidate_cells[index])
+			mask |= getPencilMarkMask(candidate_cells[index])
+		}
+
+		if countDigitsInMask(mask) != size {
+			continue
+		}
+
+		digits := getDigitsFromMask(mask)
+
+		step := newStep("Naked " + subset_names[size])
+		step.Description = fmt.Sprintf("%s can only hold %s in %s", formatCells(subset_cells), formatDigits(digits), set)
+		step.Houses = append(step.Houses, set)
+		step.Cells = append(step.Cells, subset_cells...)
+
+		for _, cell := range set.Cells {
+			if cellsContain(subset_cells, cell) {
+				continue
+			}
+
+			for _, digit := range digits {
+				step.eliminate(cell, digit)
+			}
+		}
+
+		if step.hasChanges() {
+			return step
+		}
+	}
+
+	return nil
+}
+
+func findNakedSubset(grid *sudoku.Grid, size int) (*Step, error) {
+	for _, set := range grid.GetSets() {
+		if step := findNakedSubsetInSet(set, size); step != nil {
+			return step, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func NakedPair(grid *sudoku.Grid) (*Step, error) {
+	return findNakedSubset(grid, 2)
+}
+
+func NakedTriple(grid *sudoku.Grid) (*Step, error) {
+	return findNakedSubset(grid, 3)
+}
+
+func NakedQuad(grid *sudoku.Grid) (*Step, error) {
+	return findNakedSubset(grid, 4)
+}
@@ -0,0 +1,437 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestNakedPair(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1   3 ||       |       |       ||       |   2 3 | 1 2   ||
+|| 4     |       | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |     9 |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       |       |       ||       |       | 1 2   ||
+||       |     6 |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |     6 ||
+||   8   |   8   |   8   ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 || 1 2   | 1 2   |       ||   2 3 |   2 3 | 1 2   ||
+||       |   5 6 |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7 8 9 |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |   2   ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |   8 9 ||   8   |       |       ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7 8 9 |   8 9 || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |   2   ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |   8 9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1   3 ||       |       |       ||       |   2 3 | 1 2   ||
+|| 4     |       | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |     9 |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       |       |       ||       |       |   2   ||
+||       |     6 |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |     6 ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 || 1 2   | 1 2   |       ||   2 3 |   2 3 | 1 2   ||
+||       |   5 6 |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7 8 9 |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |   2   ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |   8 9 ||   8   |       |       ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7 8 9 |   8 9 || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |   2   ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |   8 9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := NakedPair(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Naked Pair: r2c1, r2c3 can only hold {1, 8} in row 2 => r2c2<>8, r2c9<>1, r2c9<>8")
+}
+
+func TestNakedTriple(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |       | 1   3 ||       |       |     3 || 1 2 3 | 1 2 3 | 1 2   ||
+|| 4   6 |  (5)  | 4   6 ||  (7)  | 4     | 4     || 4     | 4     |       ||
+||   8 9 |       |     9 ||       |   8   |     9 ||     9 |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       | 1   3 ||       |       |     3 ||       | 1   3 |       ||
+|| 4     | 4     | 4     ||  (2)  | 4 5   | 4 5   ||  (6)  | 4     |  (7)  ||
+||   8 9 |   8 9 |     9 ||       |   8   |     9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |   2   |     3 ||     3 |       |       ||   2 3 |       |   2   ||
+|| 4     | 4     | 4     ||       |  (1)  |  (6)  || 4     |  (5)  |       ||
+|| 7 8 9 | 7 8 9 |     9 ||   8 9 |       |       ||     9 |       |   8 9 ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       | 1     || 1     | 1     |       ||
+|| 4     | 4     | 4     ||  (6)  |  (2)  |   5   || 4 5   | 4     |  (3)  ||
+||   8 9 |   8 9 |     9 ||       |       | 7   9 || 7   9 | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |   2   |   2   ||
+||  (1)  |  (3)  |  (5)  ||       |       |       || 4     | 4   6 |     6 ||
+||       |       |       ||   8 9 | 7 8   | 7   9 || 7   9 | 7     |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |   2   |       ||       |       | 1     ||       | 1 2   | 1 2   ||
+||     6 |     6 |  (7)  ||  (4)  |  (3)  |   5   ||  (8)  |     6 |   5 6 ||
+||     9 |     9 |       ||       |       |     9 ||       |       |     9 ||
+##=======================##=======================##=======================##
+||     3 |       |       || 1   3 |       |       || 1   3 | 1   3 |       ||
+||   5 6 |     6 |  (2)  ||   5   |   5   |  (8)  ||   5   |     6 |  (4)  ||
+|| 7   9 | 7   9 |       ||       | 7     |       || 7     | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       || 1   3 |       | 1 2 3 || 1 2 3 |       | 1 2   ||
+|| 4 5   | 4     |  (8)  ||   5   |  (6)  | 4 5   ||   5   |  (9)  |   5   ||
+|| 7     | 7     |       ||       |       | 7     || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||     3 |       |   2 3 ||   2 3 |   2 3 |   2   ||
+|| 4 5 6 |  (1)  | 4   6 ||   5   |  (9)  | 4 5   ||   5   |     6 |   5 6 ||
+|| 7     |       |       ||       |       | 7     || 7     | 7 8   |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |       | 1   3 ||       |       |     3 || 1 2 3 | 1 2 3 | 1 2   ||
+|| 4   6 |  (5)  | 4   6 ||  (7)  | 4     | 4     || 4     | 4     |       ||
+||   8 9 |       |     9 ||       |   8   |     9 ||     9 |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       | 1   3 ||       |       |     3 ||       | 1   3 |       ||
+|| 4     | 4     | 4     ||  (2)  | 4 5   | 4 5   ||  (6)  | 4     |  (7)  ||
+||   8 9 |   8 9 |     9 ||       |   8   |     9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |   2   |     3 ||     3 |       |       ||   2 3 |       |   2   ||
+|| 4     | 4     | 4     ||       |  (1)  |  (6)  || 4     |  (5)  |       ||
+|| 7 8 9 | 7 8 9 |     9 ||   8 9 |       |       ||     9 |       |   8 9 ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       | 1     || 1     | 1     |       ||
+|| 4     | 4     | 4     ||  (6)  |  (2)  |   5   ||   5   |       |  (3)  ||
+||   8 9 |   8 9 |     9 ||       |       | 7     || 7     | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |   2   |   2   ||
+||  (1)  |  (3)  |  (5)  ||       |       |       || 4     | 4   6 |     6 ||
+||       |       |       ||   8 9 | 7 8   | 7   9 || 7   9 | 7     |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |   2   |       ||       |       | 1     ||       | 1 2   | 1 2   ||
+||     6 |     6 |  (7)  ||  (4)  |  (3)  |   5   ||  (8)  |     6 |   5 6 ||
+||     9 |     9 |       ||       |       |     9 ||       |       |     9 ||
+##=======================##=======================##=======================##
+||     3 |       |       || 1   3 |       |       || 1   3 | 1   3 |       ||
+||   5 6 |     6 |  (2)  ||   5   |   5   |  (8)  ||   5   |     6 |  (4)  ||
+|| 7   9 | 7   9 |       ||       | 7     |       || 7     | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       || 1   3 |       | 1 2 3 || 1 2 3 |       | 1 2   ||
+|| 4 5   | 4     |  (8)  ||   5   |  (6)  | 4 5   ||   5   |  (9)  |   5   ||
+|| 7     | 7     |       ||       |       | 7     || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||     3 |       |   2 3 ||   2 3 |   2 3 |   2   ||
+|| 4 5 6 |  (1)  | 4   6 ||   5   |  (9)  | 4 5   ||   5   |     6 |   5 6 ||
+|| 7     |       |       ||       |       | 7     || 7     | 7 8   |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := NakedTriple(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Naked Triple: r4c1, r4c2, r4c3 can only hold {4, 8, 9} in row 4 => r4c6<>9, r4c7<>4, r4c7<>9, r4c8<>4")
+}
+
+func TestNakedQuad(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       | 1     | 1     ||       |       |     3 ||   2   |     3 |   2   ||
+||  (5)  | 4     | 4     ||     6 |  (9)  |       ||     6 |       |       ||
+||       |       |       || 7 8   |       | 7 8   || 7     | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |     3 ||       |     3 |       ||
+||  (7)  |  (6)  |  (8)  ||  (2)  |  (4)  |   5   ||  (1)  |   5   |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (3)  |  (2)  ||   5 6 |     6 |  (1)  ||   5 6 |  (8)  |  (4)  ||
+||       |       |       || 7     | 7     |       || 7     |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       | 1 2 3 |     3 ||   2   | 1     |       ||
+||  (4)  |  (8)  |       ||  (9)  |       |   5   ||   5   |   5   |  (6)  ||
+||       |       | 7     ||       | 7     | 7     || 7     | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (2)  |  (9)  || 4 5   |       |  (6)  || 4 5   | 4 5   |  (3)  ||
+||       |       |       || 7 8   | 7 8   |       || 7 8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 || 1     | 1 2 3 |     3 ||   2   |       | 1 2   ||
+||  (6)  |  (5)  |       || 4     |       | 4     || 4     |  (9)  |       ||
+||       |       | 7     || 7 8   | 7 8   | 7 8   || 7 8   |       | 7 8   ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     |       ||       | 1     |       ||
+||  (2)  | 4     |  (6)  ||  (3)  |       |  (9)  || 4     | 4     |  (5)  ||
+||       |       |       ||       | 7 8   |       || 7 8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||  (8)  |  (9)  |  (5)  || 4   6 |     6 | 4     ||  (3)  |  (2)  |       ||
+||       |       |       || 7     | 7     | 7     ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     || 1     |       |       ||       |       | 1     ||
+||  (3)  |  (7)  | 4     || 4     |  (5)  |  (2)  ||  (9)  |  (6)  |       ||
+||       |       |       ||   8   |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       | 1     | 1     ||       |       |     3 ||   2   |     3 |   2   ||
+||  (5)  | 4     | 4     ||     6 |  (9)  |       ||     6 |       |       ||
+||       |       |       || 7 8   |       | 7 8   || 7     | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |     3 ||       |     3 |       ||
+||  (7)  |  (6)  |  (8)  ||  (2)  |  (4)  |   5   ||  (1)  |   5   |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (3)  |  (2)  ||   5 6 |     6 |  (1)  ||   5 6 |  (8)  |  (4)  ||
+||       |       |       || 7     | 7     |       || 7     |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       |   2 3 |     3 ||   2   | 1     |       ||
+||  (4)  |  (8)  |       ||  (9)  |       |   5   ||   5   |   5   |  (6)  ||
+||       |       | 7     ||       |       | 7     || 7     | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (2)  |  (9)  || 4 5   |       |  (6)  || 4 5   | 4 5   |  (3)  ||
+||       |       |       || 7 8   | 7 8   |       || 7 8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 || 1     |   2 3 |     3 ||   2   |       | 1 2   ||
+||  (6)  |  (5)  |       || 4     |       | 4     || 4     |  (9)  |       ||
+||       |       | 7     || 7 8   |       | 7 8   || 7 8   |       | 7 8   ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     |       ||       | 1     |       ||
+||  (2)  | 4     |  (6)  ||  (3)  |       |  (9)  || 4     | 4     |  (5)  ||
+||       |       |       ||       | 7 8   |       || 7 8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||  (8)  |  (9)  |  (5)  || 4   6 |     6 | 4     ||  (3)  |  (2)  |       ||
+||       |       |       || 7     | 7     | 7     ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     || 1     |       |       ||       |       | 1     ||
+||  (3)  |  (7)  | 4     || 4     |  (5)  |  (2)  ||  (9)  |  (6)  |       ||
+||       |       |       ||   8   |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := NakedQuad(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Naked Quad: r3c5, r5c5, r7c5, r8c5 can only hold {1, 6, 7, 8} in column 5 => r4c5<>1, r4c5<>7, r6c5<>1, r6c5<>7, r6c5<>8")
+}
+
+func TestNakedPairNothingToEliminate(t *testing.T) {
+	// r6c2 and r9c2 can only hold {1, 9}, but no other cell of column 2 holds 1
+	// or 9.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |   2   ||       |       |       ||
+||  (1)  |  (8)  |  (9)  ||  (3)  |       |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |       ||       | 7     |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       | 1     | 1     || 1     |       |       ||
+||     6 |  (4)  |       ||  (5)  |       |     6 ||       |  (2)  |  (9)  ||
+|| 7     |       | 7     ||       | 7 8   |   8   || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       | 1     ||
+||   5 6 |  (2)  |   5   ||     6 |       |  (4)  ||  (3)  |     6 |       ||
+|| 7     |       | 7     ||     9 | 7 8 9 |       ||       | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||     3 |       | 1   3 || 1     | 1 2   | 1 2   ||       |       |       ||
+||       |  (5)  |       ||     6 |       |     6 || 4     | 4     |       ||
+||   8 9 |       | 7 8   ||   8 9 |     9 |     9 || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (6)  |  (2)  ||       |  (4)  |  (3)  ||       |  (1)  |  (5)  ||
+|| 7 8 9 |       |       ||   8 9 |       |       || 7 8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       || 1     |       |       ||       |       |       ||
+||       |       |  (4)  ||       |  (5)  |  (7)  ||  (2)  |  (3)  |  (6)  ||
+||   8 9 |     9 |       ||   8 9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1     | 1     ||       |       |       ||
+||  (2)  |  (7)  |   5   ||  (4)  |       |       ||  (6)  |   5   |  (3)  ||
+||       |       |   8   ||       |   8 9 |   8 9 ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       |       || 1     |       | 1     ||
+||  (4)  |  (3)  |       ||  (2)  |  (6)  |  (5)  ||       |       |       ||
+||       |       |   8   ||       |       |       || 7 8 9 | 7 8 9 | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       |       | 1     || 1     |       |       ||
+||   5   |       |  (6)  ||  (7)  |  (3)  |       || 4     | 4 5   |  (2)  ||
+||   8 9 |     9 |       ||       |       |   8   ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := NakedPair(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestNakedTripleNothingToEliminate(t *testing.T) {
+	// r7c1, r7c3 and r8c2 can only hold {4, 8, 9}, but no other cell of box 7
+	// holds them.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 ||       |       |       ||       |     3 | 1     ||
+|| 4     |  (2)  | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |       |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||       |       |       ||
+||       |  (6)  |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |  (2)  ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2   |       ||     3 |     3 | 1     ||
+||       |   5   |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7     |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |       ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |   8 9 ||   8   |       |       ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7     |   8 9 || 7 8   |     9 |       ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |       ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |       ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := NakedTriple(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestNakedQuadNothingToEliminate(t *testing.T) {
+	// r4c7, r5c7, r6c7 and r7c7 can only hold {1, 3, 4, 7}, but no other cell of
+	// column 7 holds them.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (9)  |  (7)  ||  (3)  |  (2)  |  (1)  ||  (6)  |  (8)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |  (4)  |  (6)  ||  (5)  |  (8)  |  (7)  ||  (9)  |  (2)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (8)  |  (2)  ||  (9)  |  (4)  |  (6)  ||  (5)  |  (3)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |     3 |       ||     3 |       |       ||
+|| 4     |  (5)  |  (1)  ||  (6)  |       |  (8)  || 4     |  (9)  |  (2)  ||
+|| 7     |       |       ||       | 7     |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       |       || 1     |       |       ||
+|| 4     |  (3)  |  (9)  ||       |  (5)  |  (2)  || 4     |  (6)  |  (8)  ||
+|| 7     |       |       || 7     |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1   3 |       || 1   3 | 1     |       ||
+||  (6)  |  (2)  |  (8)  ||  (4)  |       |  (9)  ||       |       |  (5)  ||
+||       |       |       ||       |       |       || 7     | 7     |       ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       || 1     |       |       ||
+||  (2)  |       |  (3)  ||  (8)  |  (6)  |  (5)  ||       |  (4)  |  (9)  ||
+||       | 7     |       ||       |       |       || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       |       ||       | 1     |       ||
+||  (8)  |  (6)  |  (5)  ||       |  (9)  |  (4)  ||  (2)  |       |  (3)  ||
+||       |       |       || 7     |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       | 1     |       ||       |       |       ||
+||  (9)  |       |  (4)  ||  (2)  |       |  (3)  ||  (8)  |  (5)  |  (6)  ||
+||       | 7     |       ||       | 7     |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := NakedQuad(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
@@ -0,0 +1,142 @@
+package strategies
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+func getPencilMarkMask(cell *sudoku.Cell) int {
+	mask := 0
+	for _, pencil_mark := range cell.GetPencilMarks() {
+		mask |= 1 << (pencil_mark - 1)
+	}
+
+	return mask
+}
+
+func getDigitsFromMask(mask int) []int {
+	digits := []int{}
+	for digit := 1; digit <= 9; digit++ {
+		if mask&(1<<(digit-1)) != 0 {
+			digits = append(digits, digit)
+		}
+	}
+
+	return digits
+}
+
+func countDigitsInMask(mask int) int {
+	return bits.OnesCount(uint(mask))
+}
+
+func getCombinations(n int, k int) [][]int {
+	combinations := [][]int{}
+	combination := make([]int, k)
+
+	var generate func(start int, depth int)
+	generate = func(start int, depth int) {
+		if depth == k {
+			combinations = append(combinations, append([]int{}, combination...))
+			return
+		}
+
+		for i := start; i <= n-(k-depth); i++ {
+			combination[depth] = i
+			generate(i+1, depth+1)
+		}
+	}
+	generate(0, 0)
+
+	return combinations
+}
+
+func formatDigits(digits []int) string {
+	digit_strings := []string{}
+	for _, digit := range digits {
+		digit_strings = append(digit_strings, fmt.Sprint(digit))
+	}
+
+	return "{" + strings.Join(digit_strings, ", ") + "}"
+}
+
+func formatCells(cells []*sudoku.Cell) string {
+	cell_strings := []string{}
+	for _, cell := range cells {
+		cell_strings = append(cell_strings, cell.String())
+	}
+
+	return strings.Join(cell_strings, ", ")
+}
+
+func cellsContain(cells []*sudoku.Cell, cell *sudoku.Cell) bool {
+	for _, other_cell := range cells {
+		if other_cell == cell {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortCells(cells []*sudoku.Cell) {
+	sort.Slice(cells, func(i int, j int) bool {
+		if cells[i].GetRowId() != cells[j].GetRowId() {
+			return cells[i].GetRowId() < cells[j].GetRowId()
+		}
+		return cells[i].GetColumnId() < cells[j].GetColumnId()
+	})
+}
+
+func getCellsWithPencilMark(cells []*sudoku.Cell, digit int) []*sudoku.Cell {
+	cells_with_pencil_mark := []*sudoku.Cell{}
+	for _, cell := range cells {
+		if cell.GetValue() == sudoku.Empty && pencilMarksContainDigit(cell.GetPencilMarks(), digit) {
+			cells_with_pencil_mark = append(cells_with_pencil_mark, cell)
+		}
+	}
+
+	return cells_with_pencil_mark
+}
+
+type candidate struct {
+	cell  *sudoku.Cell
+	digit int
+}
+
+func (c candidate) String() string {
+	return fmt.Sprintf("%s(%d)", c.cell, c.digit)
+}
+
+// sees reports whether the two candidates cannot both hold.
+func (c candidate) sees(other candidate) bool {
+	if c.cell == other.cell {
+		return c.digit != other.digit
+	}
+
+	return c.digit == other.digit && cellsSeeEachOther(c.cell, other.cell)
+}
+
+func formatCandidates(candidates []candidate) string {
+	candidate_strings := []string{}
+	for _, candidate := range candidates {
+		candidate_strings = append(candidate_strings, candidate.String())
+	}
+
+	return strings.Join(candidate_strings, ", ")
+}
+
+func sortCandidates(candidates []candidate) {
+	sort.Slice(candidates, func(i int, j int) bool {
+		if candidates[i].cell != candidates[j].cell {
+			if candidates[i].cell.GetRowId() != candidates[j].cell.GetRowId() {
+				return candidates[i].cell.GetRowId() < candidates[j].cell.GetRowId()
+			}
+			return candidates[i].cell.GetColumnId() < candidates[j].cell.GetColumnId()
+		}
+		return candidates[i].digit < candidates[j].digit
+	})
+}
@@ -0,0 +1,55 @@
+package strategies
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+func TestPencilMarkMask(t *testing.T) {
+	cell, _ := sudoku.NewCell(1, 1)
+	cell.RemovePencilMarks([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	cell.AddPencilMarks([]int{2, 5, 9})
+
+	mask := getPencilMarkMask(cell)
+
+	if countDigitsInMask(mask) != 3 {
+		t.Errorf("unexpected digit count: %d", countDigitsInMask(mask))
+	}
+
+	if !reflect.DeepEqual(getDigitsFromMask(mask), []int{2, 5, 9}) {
+		t.Errorf("unexpected digits: %v", getDigitsFromMask(mask))
+	}
+}
+
+func TestGetCombinations(t *testing.T) {
+	expected := [][]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}
+
+	if !reflect.DeepEqual(getCombinations(4, 2), expected) {
+		t.Errorf("unexpected combinations: %v", getCombinations(4, 2))
+	}
+
+	if len(getCombinations(2, 3)) != 0 {
+		t.Errorf("unexpected combinations: %v", getCombinations(2, 3))
+	}
+}
+
+func TestCandidateSees(t *testing.T) {
+	grid := sudoku.NewGrid()
+	cell_1, _ := grid.GetCell(1, 1)
+	cell_2, _ := grid.GetCell(1, 9)
+	cell_3, _ := grid.GetCell(5, 5)
+
+	if !(candidate{cell_1, 4}).sees(candidate{cell_1, 5}) {
+		t.Errorf("candidates in the same cell should see each other")
+	}
+
+	if !(candidate{cell_1, 4}).sees(candidate{cell_2, 4}) {
+		t.Errorf("candidates of the same digit in the same house should see each other")
+	}
+
+	if (candidate{cell_1, 4}).sees(candidate{cell_2, 5}) || (candidate{cell_1, 4}).sees(candidate{cell_3, 4}) || (candidate{cell_1, 4}).sees(candidate{cell_1, 4}) {
+		t.Errorf("unexpected candidates seeing each other")
+	}
+}
@@ -0,0 +1,154 @@
+package strategies
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Registry struct {
+	strategies map[string]Strategy
+}
+
+func NewRegistry() *Registry {
+	r := Registry{
+		make(map[string]Strategy),
+	}
+
+	return &r
+}
+
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+
+	for _, strategy := range []Strategy{
+		NewStrategy("Seen Cells", 0, SeenCells),
+		NewStrategy("Naked Single", 10, NakedSingle),
+		NewStrategy("Hidden Single", 15, HiddenSingle),
+		NewStrategy("Pointing", 20, Pointing),
+		NewStrategy("Box/Line Reduction", 25, BoxLineReduction),
+		NewStrategy("Naked Pair", 30, NakedPair),
+		NewStrategy("Hidden Pair", 35, HiddenPair),
+		NewStrategy("Naked Triple", 40, NakedTriple),
+		NewStrategy("Hidden Triple", 45, HiddenTriple),
+		NewStrategy("Naked Quad", 50, NakedQuad),
+		NewStrategy("Hidden Quad", 55, HiddenQuad),
+		NewUniquenessStrategy("BUG+1", 56, BUGPlus1),
+		NewUniquenessStrategy("Unique Rectangle Type 1", 56, UniqueRectangleType1),
+		NewUniquenessStrategy("Unique Rectangle Type 2", 57, UniqueRectangleType2),
+		NewUniquenessStrategy("Unique Rectangle Type 4", 58, UniqueRectangleType4),
+		NewUniquenessStrategy("Hidden Unique Rectangle", 59, HiddenUniqueRectangle),
+		NewStrategy("X-Wing", 60, XWing),
+		NewStrategy("Skyscraper", 61, Skyscraper),
+		NewStrategy("Two-String Kite", 61, TwoStringKite),
+		NewUniquenessStrategy("Unique Rectangle Type 3", 61, UniqueRectangleType3),
+		NewStrategy("Empty Rectangle", 62, EmptyRectangle),
+		NewStrategy("Finned X-Wing", 62, FinnedXWing),
+		NewUniquenessStrategy("Unique Rectangle Type 5", 63, UniqueRectangleType5),
+		NewStrategy("Sashimi X-Wing", 64, SashimiXWing),
+		NewUniquenessStrategy("Unique Rectangle Type 6", 65, UniqueRectangleType6),
+		NewStrategy("XY-Wing", 66, XYWing),
+		NewUniquenessStrategy("BUG+n", 67, BUGPlusN),
+		NewStrategy("W-Wing", 68, WWing),
+		NewStrategy("Swordfish", 70, Swordfish),
+		NewStrategy("Finned Swordfish", 72, FinnedSwordfish),
+		NewStrategy("Sashimi Swordfish", 74, SashimiSwordfish),
+		NewStrategy("XYZ-Wing", 76, XYZWing),
+		NewStrategy("Jellyfish", 80, Jellyfish),
+		NewStrategy("Finned Jellyfish", 82, FinnedJellyfish),
+		NewStrategy("Sashimi Jellyfish", 84, SashimiJellyfish),
+		NewStrategy("WXYZ-Wing", 86, WXYZWing),
+		NewStrategy("M-Wing", 88, MWing),
+		NewStrategy("Simple Colouring", 90, SimpleColouring),
+		NewStrategy("Remote Pairs", 92, RemotePairs),
+		NewStrategy("Multi-Colouring", 94, MultiColouring),
+		NewStrategy("Bent Set", 98, BentSet),
+		NewStrategy("X-Cycle", 100, XCycle),
+		NewStrategy("Grouped X-Cycle", 104, GroupedXCycle),
+		NewStrategy("XY-Chain", 106, XYChain),
+		NewStrategy("3D Medusa", 110, Medusa),
+		NewStrategy("Sue de Coq", 115, SueDeCoq),
+		NewStrategy("AIC", 120, AIC),
+		NewStrategy("ALS-XZ", 130, ALSXZ),
+		NewStrategy("ALS-XY-Wing", 135, ALSXYWing),
+		NewStrategy("ALS Chain", 140, ALSChain),
+	} {
+		if err := r.Register(strategy); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	return r
+}
+
+func (r *Registry) Register(strategy Strategy) error {
+	name := strategy.GetName()
+
+	if name == "" {
+		return fmt.Errorf("strategy name cannot be empty")
+	}
+
+	if _, found := r.strategies[name]; found {
+		return fmt.Errorf("strategy is already registered: %s", name)
+	}
+
+	r.strategies[name] = strategy
+	return nil
+}
+
+func (r *Registry) GetStrategy(name string) (Strategy, error) {
+	strategy, found := r.strategies[name]
+	if !found {
+		return nil, fmt.Errorf("unknown strategy: %s", name)
+	}
+
+	return strategy, nil
+}
+
+func (r *Registry) GetStrategies() []Strategy {
+	strategies := []Strategy{}
+	for _, strategy := range r.strategies {
+		strategies = append(strategies, strategy)
+	}
+
+	sort.Slice(strategies, func(i int, j int) bool {
+		if strategies[i].GetDifficulty() != strategies[j].GetDifficulty() {
+			return strategies[i].GetDifficulty() < strategies[j].GetDifficulty()
+		}
+		return strategies[i].GetName() < strategies[j].GetName()
+	})
+
+	return strategies
+}
+
+func (r *Registry) GetStrategiesByName(names []string) ([]Strategy, error) {
+	strategies := []Strategy{}
+
+	for _, name := range names {
+		strategy, err := r.GetStrategy(name)
+		if err != nil {
+			return nil, err
+		}
+
+		strategies = append(strategies, strategy)
+	}
+
+	return strategies, nil
+}
+
+// Config has one strategy name per line, in the order they should be tried.
+// Empty lines and lines starting with '#' are ignored.
+func (r *Registry) LoadConfig(config string) ([]Strategy, error) {
+	names := []string{}
+
+	for _, line := range strings.Split(config, "\n") {
+		name := strings.TrimSpace(line)
+		if name == "" || strings.HasPrefix(name, "#") {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return r.GetStrategiesByName(names)
+}
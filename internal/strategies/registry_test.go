@@ -0,0 +1,123 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func assertStrategyNames(t *testing.T, actual []Strategy, expected []string) {
+	if len(actual) != len(expected) {
+		t.Errorf("unexpected number of strategies. expected: %d, actual: %d", len(expected), len(actual))
+		return
+	}
+
+	for i, strategy := range actual {
+		if strategy.GetName() != expected[i] {
+			t.Errorf("unexpected strategy. expected: %s, actual: %s", expected[i], strategy.GetName())
+		}
+	}
+}
+
+func noop(grid *sudoku.Grid) (*Step, error) {
+	return nil, nil
+}
+
+func TestNewStrategy(t *testing.T) {
+	strategy := NewStrategy("Noop", 42, noop)
+
+	if strategy.GetName() != "Noop" {
+		t.Errorf("unexpected name: %s", strategy.GetName())
+	}
+
+	if strategy.GetDifficulty() != 42 {
+		t.Errorf("unexpected difficulty: %d", strategy.GetDifficulty())
+	}
+
+	step, err := strategy.Apply(sudoku.NewGrid())
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+}
+
+func TestWithoutUniqueness(t *testing.T) {
+	strategy_list := []Strategy{
+		NewStrategy("Noop", 1, noop),
+		NewUniquenessStrategy("Unique Noop", 2, noop),
+	}
+
+	if strategy_list[0].RequiresUniqueness() || !strategy_list[1].RequiresUniqueness() {
+		t.Errorf("unexpected uniqueness requirement")
+	}
+
+	assertStrategyNames(t, WithoutUniqueness(strategy_list), []string{"Noop"})
+}
+
+func TestRegister(t *testing.T) {
+	registry := NewRegistry()
+
+	AssertNoError(t, registry.Register(NewStrategy("Noop", 42, noop)))
+	AssertError(t, registry.Register(NewStrategy("Noop", 1, noop)))
+	AssertError(t, registry.Register(NewStrategy("", 1, noop)))
+
+	strategy, err := registry.GetStrategy("Noop")
+	AssertNoError(t, err)
+	if strategy.GetDifficulty() != 42 {
+		t.Errorf("unexpected difficulty: %d", strategy.GetDifficulty())
+	}
+
+	_, err = registry.GetStrategy("Unknown")
+	AssertError(t, err)
+}
+
+func TestGetStrategiesOrderedByDifficulty(t *testing.T) {
+	registry := NewRegistry()
+	AssertNoError(t, registry.Register(NewStrategy("Hidden Single", 15, HiddenSingle)))
+	AssertNoError(t, registry.Register(NewStrategy("Noop", 12, noop)))
+	AssertNoError(t, registry.Register(NewStrategy("Naked Single", 10, NakedSingle)))
+	AssertNoError(t, registry.Register(NewStrategy("Another Noop", 12, noop)))
+
+	assertStrategyNames(t, registry.GetStrategies(), []string{"Naked Single", "Another Noop", "Noop", "Hidden Single"})
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	strategies := NewDefaultRegistry().GetStrategies()
+
+	if strategies[0].GetName() != "Seen Cells" {
+		t.Errorf("unexpected first strategy: %s", strategies[0].GetName())
+	}
+
+	for i := 1; i < len(strategies); i++ {
+		if strategies[i-1].GetDifficulty() > strategies[i].GetDifficulty() {
+			t.Errorf("strategies are not ordered by difficulty: %s, %s", strategies[i-1].GetName(), strategies[i].GetName())
+		}
+	}
+}
+
+func TestGetStrategiesByName(t *testing.T) {
+	registry := NewDefaultRegistry()
+
+	strategies, err := registry.GetStrategiesByName([]string{"Hidden Single", "Seen Cells"})
+	AssertNoError(t, err)
+	assertStrategyNames(t, strategies, []string{"Hidden Single", "Seen Cells"})
+
+	_, err = registry.GetStrategiesByName([]string{"Hidden Single", "Unknown"})
+	AssertError(t, err)
+}
+
+func TestLoadConfig(t *testing.T) {
+	registry := NewDefaultRegistry()
+
+	strategies, err := registry.LoadConfig(`
+# singles only
+Seen Cells
+
+  Hidden Single
+Naked Single
+`)
+	AssertNoError(t, err)
+	assertStrategyNames(t, strategies, []string{"Seen Cells", "Hidden Single", "Naked Single"})
+
+	_, err = registry.LoadConfig("Seen Cells\nUnknown\n")
+	AssertError(t, err)
+}
@@ -1,8 +1,6 @@
 package strategies
 
 import (
-	"reflect"
-
 	"github.com/alltilla/sudoku-solver/internal/sudoku"
 )
 
@@ -30,8 +28,41 @@ func getAllCellsSeeing(grid *sudoku.Grid, cell *sudoku.Cell) [27]*sudoku.Cell {
 	return cells
 }
 
-func SeenCells(grid *sudoku.Grid) (bool, error) {
-	changed := false
+func getPeers(grid *sudoku.Grid, cell *sudoku.Cell) []*sudoku.Cell {
+	peers := []*sudoku.Cell{}
+
+	for _, other_cell := range getAllCellsSeeing(grid, cell) {
+		if other_cell != cell && !cellsContain(peers, other_cell) {
+			peers = append(peers, other_cell)
+		}
+	}
+
+	return peers
+}
+
+func cellsSeeEachOther(cell *sudoku.Cell, other_cell *sudoku.Cell) bool {
+	if cell == other_cell {
+		return false
+	}
+
+	return cell.GetRowId() == other_cell.GetRowId() ||
+		cell.GetColumnId() == other_cell.GetColumnId() ||
+		cell.GetBoxId() == other_cell.GetBoxId()
+}
+
+func cellSeesAll(cell *sudoku.Cell, other_cells []*sudoku.Cell) bool {
+	for _, other_cell := range other_cells {
+		if !cellsSeeEachOther(cell, other_cell) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func SeenCells(grid *sudoku.Grid) (*Step, error) {
+	step := newStep("Seen Cells")
+	step.Description = "removed digits already placed in the same row, column or box"
 
 	for _, cell := range grid.GetAllCells() {
 		if cell.GetValue() != sudoku.Empty {
@@ -43,17 +74,13 @@ func SeenCells(grid *sudoku.Grid) (bool, error) {
 				continue
 			}
 
-			old_pencil_marks := cell.GetPencilMarks()
-
-			if err := cell.RemovePencilMark(other_cell.GetValue()); err != nil {
-				panic(err.Error())
-			}
-
-			if !changed && !reflect.DeepEqual(cell.GetPencilMarks(), old_pencil_marks) {
-				changed = true
-			}
+			step.eliminate(cell, other_cell.GetValue())
 		}
 	}
 
-	return changed, nil
+	if !step.hasChanges() {
+		return nil, nil
+	}
+
+	return step, nil
 }
@@ -91,13 +91,34 @@ func TestSeenCells(t *testing.T) {
 	grid := sudoku.NewGrid()
 	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
 
-	changed, err := SeenCells(grid)
+	step, err := SeenCells(grid)
 	AssertNoError(t, err)
-	AssertChanged(t, changed)
+	AssertChanged(t, step)
 	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
 
-	changed, err = SeenCells(grid)
+	step, err = SeenCells(grid)
 	AssertNoError(t, err)
-	AssertNoChanged(t, changed)
+	AssertNoChanged(t, step)
 	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
 }
+
+func TestGetPeers(t *testing.T) {
+	grid := sudoku.NewGrid()
+	cell, _ := grid.GetCell(5, 5)
+
+	peers := getPeers(grid, cell)
+	if len(peers) != 20 {
+		t.Errorf("unexpected number of peers: %d", len(peers))
+	}
+
+	for _, peer := range peers {
+		if !cellsSeeEachOther(cell, peer) {
+			t.Errorf("%s should see %s", cell, peer)
+		}
+	}
+
+	other_cell, _ := grid.GetCell(1, 1)
+	if cellsSeeEachOther(cell, other_cell) || cellsSeeEachOther(cell, cell) {
+		t.Errorf("%s should not see %s or itself", cell, other_cell)
+	}
+}
@@ -0,0 +1,212 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+type conjugatePair struct {
+	house *sudoku.Set
+	cells [2]*sudoku.Cell
+}
+
+func (p conjugatePair) String() string {
+	return fmt.Sprintf("%s=%s", p.cells[0], p.cells[1])
+}
+
+func getHouseConjugatePairs(houses []*sudoku.Set, digit int) []conjugatePair {
+	conjugate_pairs := []conjugatePair{}
+
+	for _, house := range houses {
+		cells := getCellsWithPencilMark(house.Cells[:], digit)
+		if len(cells) == 2 {
+			conjugate_pairs = append(conjugate_pairs, conjugatePair{house, [2]*sudoku.Cell{cells[0], cells[1]}})
+		}
+	}
+
+	return conjugate_pairs
+}
+
+func setIntersectsSet(set *sudoku.Set, other_set *sudoku.Set) bool {
+	for _, cell := range set.Cells {
+		if cellsContain(other_set.Cells[:], cell) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyTurbotFish handles two conjugate pairs whose bases, cells[i] and
+// other_pair.cells[j], see each other in base. At most one of the bases holds
+// the digit, so at least one of the other two ends does.
+func applyTurbotFish(grid *sudoku.Grid, technique string, digit int, pair conjugatePair, other_pair conjugatePair, i int, j int, base *sudoku.Set) *Step {
+	ends := []*sudoku.Cell{pair.cells[1-i], other_pair.cells[1-j]}
+
+	step := newStep(technique)
+	step.Description = fmt.Sprintf("%d in %s %s and %s %s, linked in %s; %s or %s holds %d", digit, pair.house, pair, other_pair.house, other_pair, base, ends[0], ends[1], digit)
+	step.Houses = append(step.Houses, pair.house, other_pair.house, base)
+	step.Cells = append(step.Cells, pair.cells[:]...)
+	step.Cells = append(step.Cells, other_pair.cells[:]...)
+
+	eliminateFromCellsSeeingAll(step, grid, ends, digit)
+
+	if !step.hasChanges() {
+		return nil
+	}
+
+	return step
+}
+
+// Skyscraper is two conjugate pairs in parallel lines with one end of each
+// sharing a line. The other ends are not aligned, otherwise it is an X-Wing.
+func Skyscraper(grid *sudoku.Grid) (*Step, error) {
+	sets := grid.GetSets()
+
+	for digit := 1; digit <= 9; digit++ {
+		for _, lines := range [][]*sudoku.Set{sets[:9], sets[9:18]} {
+			conjugate_pairs := getHouseConjugatePairs(lines, digit)
+
+			for _, combination := range getCombinations(len(conjugate_pairs), 2) {
+				pair := conjugate_pairs[combination[0]]
+				other_pair := conjugate_pairs[combination[1]]
+
+				for i := 0; i < 2; i++ {
+					for j := 0; j < 2; j++ {
+						base := getCommonLine(sets, []*sudoku.Cell{pair.cells[i], other_pair.cells[j]})
+						if base == nil || getCommonLine(sets, []*sudoku.Cell{pair.cells[1-i], other_pair.cells[1-j]}) != nil {
+							continue
+						}
+
+						if step := applyTurbotFish(grid, "Skyscraper", digit, pair, other_pair, i, j, base); step != nil {
+							return step, nil
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// TwoStringKite is a conjugate pair in a row and one in a column with one end
+// of each sharing a box.
+func TwoStringKite(grid *sudoku.Grid) (*Step, error) {
+	sets := grid.GetSets()
+
+	for digit := 1; digit <= 9; digit++ {
+		row_pairs := getHouseConjugatePairs(sets[:9], digit)
+		column_pairs := getHouseConjugatePairs(sets[9:18], digit)
+
+		for _, row_pair := range row_pairs {
+			for _, column_pair := range column_pairs {
+				if cellsContain(column_pair.cells[:], row_pair.cells[0]) || cellsContain(column_pair.cells[:], row_pair.cells[1]) {
+					continue
+				}
+
+				for i := 0; i < 2; i++ {
+					for j := 0; j < 2; j++ {
+						base := getCommonBox(sets, []*sudoku.Cell{row_pair.cells[i], column_pair.cells[j]})
+						if base == nil {
+							continue
+						}
+
+						if step := applyTurbotFish(grid, "Two-String Kite", digit, row_pair, column_pair, i, j, base); step != nil {
+							return step, nil
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// findEmptyRectangle looks for a conjugate pair outside the box with one end
+// in line. If the cell of other_line sharing a line with the other end held
+// the digit, that end would not, the first end would, and the box would have
+// no room left for the digit.
+func findEmptyRectangle(grid *sudoku.Grid, digit int, box *sudoku.Set, line *sudoku.Set, other_line *sudoku.Set, conjugate_pairs []conjugatePair) *Step {
+	sets := grid.GetSets()
+
+	for _, pair := range conjugate_pairs {
+		if cellsContain(box.Cells[:], pair.cells[0]) || cellsContain(box.Cells[:], pair.cells[1]) {
+			continue
+		}
+
+		for i := 0; i < 2; i++ {
+			if !cellsContain(line.Cells[:], pair.cells[i]) {
+				continue
+			}
+
+			for _, cell := range other_line.Cells {
+				if cellsContain(box.Cells[:], cell) || cell == pair.cells[1-i] || getCommonLine(sets, []*sudoku.Cell{cell, pair.cells[1-i]}) == nil {
+					continue
+				}
+
+				step := newStep("Empty Rectangle")
+				step.Description = fmt.Sprintf("%d in %s is confined to %s and %s, conjugate pair %s in %s", digit, box, line, other_line, pair, pair.house)
+				step.Houses = append(step.Houses, box, pair.house)
+				step.Cells = append(step.Cells, getCellsWithPencilMark(box.Cells[:], digit)...)
+				step.Cells = append(step.Cells, pair.cells[:]...)
+				step.eliminate(cell, digit)
+
+				if step.hasChanges() {
+					return step
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// EmptyRectangle uses a box whose candidates of a digit all lie in one row
+// and one column of it, but not in a single line.
+func EmptyRectangle(grid *sudoku.Grid) (*Step, error) {
+	sets := grid.GetSets()
+
+	for digit := 1; digit <= 9; digit++ {
+		row_pairs := getHouseConjugatePairs(sets[:9], digit)
+		column_pairs := getHouseConjugatePairs(sets[9:18], digit)
+
+		for _, box := range sets[18:] {
+			box_cells := getCellsWithPencilMark(box.Cells[:], digit)
+			if len(box_cells) < 2 || getCommonLine(sets, box_cells) != nil {
+				continue
+			}
+
+			for _, row := range sets[:9] {
+				for _, column := range sets[9:18] {
+					if !setIntersectsSet(row, box) || !setIntersectsSet(column, box) {
+						continue
+					}
+
+					confined := true
+					for _, cell := range box_cells {
+						if !cellsContain(row.Cells[:], cell) && !cellsContain(column.Cells[:], cell) {
+							confined = false
+							break
+						}
+					}
+					if !confined {
+						continue
+					}
+
+					if step := findEmptyRectangle(grid, digit, box, row, column, column_pairs); step != nil {
+						return step, nil
+					}
+
+					if step := findEmptyRectangle(grid, digit, box, column, row, row_pairs); step != nil {
+						return step, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,437 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestSkyscraper(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||     3 |       |     3 ||       |       |       ||       |     3 |       ||
+|| 4     |  (2)  | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |  (1)  ||
+||     9 |       |     9 ||       |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||       |       |       ||
+||       |  (6)  |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |  (2)  ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 || 1 2   | 1 2   |       ||     3 |     3 |       ||
+||       |   5   |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7   9 | 7     |     9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |       ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |       ||       |       |       ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 ||       |     3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7     |   8 9 || 7 8   |     9 |       ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |       ||
+|| 4     |  (1)  | 4     ||  (3)  |       |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |       ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||     3 |       |     3 ||       |       |       ||       |     3 |       ||
+|| 4     |  (2)  | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |  (1)  ||
+||     9 |       |     9 ||       |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||       |       |       ||
+||       |  (6)  |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |  (2)  ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 || 1 2   | 1 2   |       ||     3 |     3 |       ||
+||       |   5   |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7   9 | 7     |     9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |       ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |       ||       |       |       ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 ||       |     3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7     |   8 9 || 7 8   |     9 |       ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |       ||
+|| 4     |  (1)  | 4     ||  (3)  |       |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2   | 1     ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |       ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Skyscraper(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Skyscraper: 2 in row 6 r6c6=r6c8 and row 7 r7c5=r7c8, linked in column 8; r6c6 or r7c5 holds 2 => r8c6<>2")
+}
+
+func TestTwoStringKite(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1   3 | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1 2 3 ||       |       | 1 2 3 ||   2   |   2   | 1 2   ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+|| 7 8   |       | 7 8   ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2 3 | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1   3 | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1 2 3 ||       |       | 1 2 3 ||   2   |   2   | 1     ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+|| 7 8   |       | 7 8   ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2 3 | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := TwoStringKite(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Two-String Kite: 2 in row 9 r9c4=r9c9 and column 6 r5c6=r8c6, linked in box 8; r9c9 or r5c6 holds 2 => r5c9<>2")
+}
+
+func TestEmptyRectangle(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |       ||       |       |       ||
+||  (8)  |  (1)  |  (2)  ||     6 |       |  (5)  ||  (7)  |     6 |  (4)  ||
+||       |       |       ||     9 |     9 |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||     6 |     6 |     6 ||  (1)  |  (2)  |  (4)  ||  (5)  |  (8)  |  (3)  ||
+||     9 | 7   9 | 7   9 ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       || 1 2   | 1     |   2   ||
+||  (4)  |  (3)  |  (5)  ||     6 |  (8)  |  (7)  ||     6 |     6 |       ||
+||       |       |       ||     9 |       |       ||     9 |     9 |     9 ||
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1     ||       | 1   3 |       ||       |     3 |   2   ||
+||   5   |   5   |       ||  (7)  |   5   |  (6)  ||  (4)  |   5   |   5   ||
+||     9 |     9 |   8 9 ||       |       |       ||       |     9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     ||       |       | 1     ||       |     3 |       ||
+||   5   |   5   |       ||  (2)  |  (4)  |       ||       |   5   |  (6)  ||
+||     9 | 7   9 | 7 8 9 ||       |       |   8   ||   8 9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |   2   |       ||     3 |     3 |       ||   2   |     3 |       ||
+||   5 6 |   5 6 |  (4)  ||       |   5   |  (9)  ||       |   5   |  (1)  ||
+||       | 7     |       ||   8   |       |       ||   8   | 7     |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       |       |       || 1     |       |       ||
+||  (2)  |  (8)  |       ||  (5)  |  (6)  |  (3)  ||       |  (4)  |  (7)  ||
+||       |       |     9 ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       | 1     ||       |       |       ||
+||   5 6 |   5 6 |     6 ||  (4)  |  (7)  |       ||  (3)  |  (2)  |       ||
+||     9 |     9 |     9 ||       |       |   8   ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1     |       || 1     | 1     |       ||
+||  (7)  |  (4)  |  (3)  ||       |       |  (2)  ||     6 |   5 6 |   5   ||
+||       |       |       ||   8 9 |     9 |       ||   8   |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |       ||       |       |       ||
+||  (8)  |  (1)  |  (2)  ||     6 |       |  (5)  ||  (7)  |     6 |  (4)  ||
+||       |       |       ||     9 |     9 |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||     6 |     6 |     6 ||  (1)  |  (2)  |  (4)  ||  (5)  |  (8)  |  (3)  ||
+||     9 | 7   9 | 7   9 ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       || 1 2   | 1     |   2   ||
+||  (4)  |  (3)  |  (5)  ||     6 |  (8)  |  (7)  ||     6 |     6 |       ||
+||       |       |       ||     9 |       |       ||     9 |     9 |     9 ||
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1     ||       | 1   3 |       ||       |     3 |   2   ||
+||   5   |   5   |       ||  (7)  |   5   |  (6)  ||  (4)  |   5   |   5   ||
+||     9 |     9 |   8 9 ||       |       |       ||       |     9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       |       ||       |       | 1     ||       |     3 |       ||
+||   5   |   5   |       ||  (2)  |  (4)  |       ||       |   5   |  (6)  ||
+||     9 | 7   9 | 7 8 9 ||       |       |   8   ||   8 9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |   2   |       ||     3 |     3 |       ||   2   |     3 |       ||
+||   5 6 |   5 6 |  (4)  ||       |   5   |  (9)  ||       |   5   |  (1)  ||
+||       | 7     |       ||   8   |       |       ||   8   | 7     |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       |       |       || 1     |       |       ||
+||  (2)  |  (8)  |       ||  (5)  |  (6)  |  (3)  ||       |  (4)  |  (7)  ||
+||       |       |     9 ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       | 1     ||       |       |       ||
+||   5 6 |   5 6 |     6 ||  (4)  |  (7)  |       ||  (3)  |  (2)  |       ||
+||     9 |     9 |     9 ||       |       |   8   ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1     |       || 1     | 1     |       ||
+||  (7)  |  (4)  |  (3)  ||       |       |  (2)  ||     6 |   5 6 |   5   ||
+||       |       |       ||   8 9 |     9 |       ||   8   |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := EmptyRectangle(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Empty Rectangle: 1 in box 7 is confined to row 8 and column 3, conjugate pair r5c6=r8c6 in column 6 => r5c3<>1")
+}
+
+func TestSkyscraperNothingToEliminate(t *testing.T) {
+	// 6 forms the skyscraper r2c2=r3c2-r3c8=r7c8 and 7 forms two more, but no
+	// cell seeing both ends of any of them holds the digit.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1   3 ||       |       |       ||       |   2 3 | 1 2   ||
+|| 4     |       | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |     9 |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       |       |       ||       |       | 1 2   ||
+||       |     6 |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |     6 ||
+||   8   |   8   |   8   ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 || 1 2   | 1 2   |       ||   2 3 |   2 3 | 1 2   ||
+||       |   5 6 |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7 8 9 |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |   2   ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |   8 9 ||   8   |       |       ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7 8 9 |   8 9 || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |   2   ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |   8 9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := Skyscraper(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestTwoStringKiteNothingToEliminate(t *testing.T) {
+	// 7 forms the kite r6c3=r6c6-r5c5=r2c5, linked in box 5, but r2c3, the only
+	// cell seeing both ends, holds no 7.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |   2   |       ||       |       |       ||       |       |   2   ||
+||  (3)  |       |  (5)  ||  (1)  |  (4)  |  (6)  ||  (7)  |       |       ||
+||       |     9 |       ||       |       |       ||       |   8 9 |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   | 1     ||       |   2   |       || 1     |       |       ||
+||  (4)  |       |       ||  (3)  |       |       ||     6 |     6 |  (5)  ||
+||       |     9 |   8   ||       | 7     | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |   2   |       || 1   3 |       |   2 3 ||
+||  (7)  |  (6)  |       ||  (5)  |       |       ||       |  (4)  |       ||
+||       |       |   8   ||       |     9 |   8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  | 4     ||  (9)  |  (8)  |  (1)  || 4   6 |  (5)  |     6 ||
+||       |       | 7     ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |     3 |     3 ||
+||  (6)  |  (1)  |  (9)  ||  (2)  |   5   | 4 5   || 4     |       |       ||
+||       |       |       ||       | 7     | 7     ||       |   8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (8)  | 4     ||  (6)  |  (3)  | 4     ||  (2)  |  (1)  |  (9)  ||
+||       |       | 7     ||       |       | 7     ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||  (1)  |  (7)  |  (2)  ||  (4)  |   5   |   5   ||  (8)  |     6 |     6 ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (5)  |  (6)  ||  (7)  |  (1)  |  (3)  ||  (9)  |  (2)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (4)  |  (3)  ||  (8)  |  (6)  |  (2)  ||  (5)  |  (7)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := TwoStringKite(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestEmptyRectangleNothingToEliminate(t *testing.T) {
+	// 1 in box 4 is confined to row 6 and column 3, and r6c6=r8c6 is a
+	// conjugate pair in column 6, but r8c3 is solved.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1   3 ||       |       |       ||       |   2 3 | 1 2   ||
+|| 4     |       | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |       ||
+||     9 |     9 |     9 ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       |       |       ||       |       | 1 2   ||
+||       |     6 |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |     6 ||
+||   8   |   8   |   8   ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 || 1 2   | 1 2   |       ||   2 3 |   2 3 | 1 2   ||
+||       |   5 6 |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7 8 9 | 7 8 9 |   8 9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |   2   ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |   8 9 ||   8   |       |       ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 || 1 2   | 1 2 3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7 8 9 |   8 9 || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |   2   ||
+|| 4     |  (1)  | 4     ||  (3)  |     6 |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1 2   | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |   8 9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := EmptyRectangle(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
@@ -0,0 +1,87 @@
+package strategies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+type Placement struct {
+	Cell  *sudoku.Cell
+	Digit int
+}
+
+type Elimination struct {
+	Cell  *sudoku.Cell
+	Digit int
+}
+
+type Step struct {
+	Technique         string
+	Description       string
+	AssumesUniqueness bool
+	Houses            []*sudoku.Set
+	Cells             []*sudoku.Cell
+	Placements        []Placement
+	Eliminations      []Elimination
+}
+
+func newStep(technique string) *Step {
+	s := Step{
+		Technique:    technique,
+		Houses:       []*sudoku.Set{},
+		Cells:        []*sudoku.Cell{},
+		Placements:   []Placement{},
+		Eliminations: []Elimination{},
+	}
+
+	return &s
+}
+
+func (s *Step) place(cell *sudoku.Cell, digit int) {
+	if err := cell.SetValue(digit); err != nil {
+		panic(err.Error())
+	}
+
+	s.Placements = append(s.Placements, Placement{cell, digit})
+}
+
+func (s *Step) eliminate(cell *sudoku.Cell, digit int) bool {
+	if cell.GetValue() != sudoku.Empty || !pencilMarksContainDigit(cell.GetPencilMarks(), digit) {
+		return false
+	}
+
+	if err := cell.RemovePencilMark(digit); err != nil {
+		panic(err.Error())
+	}
+
+	s.Eliminations = append(s.Eliminations, Elimination{cell, digit})
+	return true
+}
+
+func (s *Step) hasChanges() bool {
+	return len(s.Placements) > 0 || len(s.Eliminations) > 0
+}
+
+func (s *Step) String() string {
+	changes := []string{}
+
+	for _, placement := range s.Placements {
+		changes = append(changes, fmt.Sprintf("%s=%d", placement.Cell, placement.Digit))
+	}
+
+	for _, elimination := range s.Eliminations {
+		changes = append(changes, fmt.Sprintf("%s<>%d", elimination.Cell, elimination.Digit))
+	}
+
+	explanation := s.Technique
+	if s.Description != "" {
+		explanation += ": " + s.Description
+	}
+	if s.AssumesUniqueness {
+		explanation += " (assuming a unique solution)"
+	}
+
+	return fmt.Sprintf("%s => %s", explanation, strings.Join(changes, ", "))
+}
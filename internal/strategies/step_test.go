@@ -0,0 +1,70 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+func TestStepPlaceAndEliminate(t *testing.T) {
+	grid := sudoku.NewGrid()
+	cell_1, _ := grid.GetCell(1, 1)
+	cell_2, _ := grid.GetCell(2, 3)
+
+	step := newStep("Test")
+	if step.hasChanges() {
+		t.Errorf("new step should not have changes")
+	}
+
+	step.place(cell_1, 5)
+	if cell_1.GetValue() != 5 {
+		t.Errorf("unexpected value: %d", cell_1.GetValue())
+	}
+
+	if !step.eliminate(cell_2, 4) {
+		t.Errorf("missing elimination")
+	}
+	if step.eliminate(cell_2, 4) {
+		t.Errorf("elimination of a missing pencil mark should not be recorded")
+	}
+	if step.eliminate(cell_1, 3) {
+		t.Errorf("elimination in a solved cell should not be recorded")
+	}
+
+	if !step.hasChanges() {
+		t.Errorf("missing changes")
+	}
+	if len(step.Placements) != 1 || len(step.Eliminations) != 1 {
+		t.Errorf("unexpected number of changes. placements: %d, eliminations: %d", len(step.Placements), len(step.Eliminations))
+	}
+}
+
+func TestStepString(t *testing.T) {
+	grid := sudoku.NewGrid()
+	cell_1, _ := grid.GetCell(1, 1)
+	cell_2, _ := grid.GetCell(2, 3)
+
+	step := newStep("Test")
+	step.place(cell_1, 5)
+	step.eliminate(cell_2, 4)
+	step.eliminate(cell_2, 6)
+
+	expected := "Test => r1c1=5, r2c3<>4, r2c3<>6"
+	if step.String() != expected {
+		t.Errorf("unexpected string. expected: %s, actual: %s", expected, step.String())
+	}
+
+	step.Description = "because of reasons"
+
+	expected = "Test: because of reasons => r1c1=5, r2c3<>4, r2c3<>6"
+	if step.String() != expected {
+		t.Errorf("unexpected string. expected: %s, actual: %s", expected, step.String())
+	}
+
+	step.AssumesUniqueness = true
+
+	expected = "Test: because of reasons (assuming a unique solution) => r1c1=5, r2c3<>4, r2c3<>6"
+	if step.String() != expected {
+		t.Errorf("unexpected string. expected: %s, actual: %s", expected, step.String())
+	}
+}
@@ -0,0 +1,74 @@
+package strategies
+
+import (
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+type ApplyFunc func(grid *sudoku.Grid) (*Step, error)
+
+type Strategy interface {
+	GetName() string
+	GetDifficulty() int
+	RequiresUniqueness() bool
+	Apply(grid *sudoku.Grid) (*Step, error)
+}
+
+type strategy struct {
+	name                string
+	difficulty          int
+	requires_uniqueness bool
+	apply               ApplyFunc
+}
+
+func NewStrategy(name string, difficulty int, apply ApplyFunc) Strategy {
+	s := strategy{
+		name,
+		difficulty,
+		false,
+		apply,
+	}
+
+	return &s
+}
+
+// NewUniquenessStrategy creates a strategy that is only sound for puzzles
+// with a single solution.
+func NewUniquenessStrategy(name string, difficulty int, apply ApplyFunc) Strategy {
+	s := strategy{
+		name,
+		difficulty,
+		true,
+		apply,
+	}
+
+	return &s
+}
+
+// WithoutUniqueness drops the strategies requiring a unique solution, for
+// puzzles where that is not guaranteed.
+func WithoutUniqueness(strategy_list []Strategy) []Strategy {
+	strategies := []Strategy{}
+	for _, strategy := range strategy_list {
+		if !strategy.RequiresUniqueness() {
+			strategies = append(strategies, strategy)
+		}
+	}
+
+	return strategies
+}
+
+func (s *strategy) GetName() string {
+	return s.name
+}
+
+func (s *strategy) GetDifficulty() int {
+	return s.difficulty
+}
+
+func (s *strategy) RequiresUniqueness() bool {
+	return s.requires_uniqueness
+}
+
+func (s *strategy) Apply(grid *sudoku.Grid) (*Step, error) {
+	return s.apply(grid)
+}
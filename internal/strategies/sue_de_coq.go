@@ -0,0 +1,108 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+// findSueDeCoq looks at two or three cells of a box-line intersection holding
+// at least two more candidates than cells. An almost locked set of the line
+// outside the box and one of the box outside the line, sharing no digit, can
+// together with the intersection cover as many digits as they have cells.
+// Then every one of those digits is placed exactly once: the digits of the
+// line set stay in the line, the digits of the box set stay in the box and
+// the rest of the intersection digits stay in both.
+func findSueDeCoq(line *sudoku.Set, box *sudoku.Set) *Step {
+	intersection_cells := []*sudoku.Cell{}
+	for _, cell := range line.Cells {
+		if cell.GetValue() == sudoku.Empty && cellsContain(box.Cells[:], cell) {
+			intersection_cells = append(intersection_cells, cell)
+		}
+	}
+
+	for size := 2; size <= len(intersection_cells); size++ {
+		for _, combination := range getCombinations(len(intersection_cells), size) {
+			cells := []*sudoku.Cell{}
+			mask := 0
+			for _, i := range combination {
+				cells = append(cells, intersection_cells[i])
+				mask |= getPencilMarkMask(intersection_cells[i])
+			}
+
+			if countDigitsInMask(mask) < size+2 {
+				continue
+			}
+
+			for _, line_als := range getALSCombinations(line, box.Cells[:]) {
+				for _, box_als := range getALSCombinations(box, line.Cells[:]) {
+					if line_als.mask&box_als.mask != 0 {
+						continue
+					}
+
+					cell_count := size + len(line_als.cells) + len(box_als.cells)
+					if countDigitsInMask(mask|line_als.mask|box_als.mask) != cell_count {
+						continue
+					}
+
+					if step := applySueDeCoq(cells, mask, line_als, box_als); step != nil {
+						return step
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func applySueDeCoq(cells []*sudoku.Cell, mask int, line_als *almostLockedSet, box_als *almostLockedSet) *Step {
+	line_mask := line_als.mask | mask&^box_als.mask
+	box_mask := box_als.mask | mask&^line_als.mask
+
+	step := newStep("Sue de Coq")
+	step.Description = fmt.Sprintf("intersection %s %s, %s ALS %s, %s ALS %s; %s locked in %s, %s locked in %s",
+		formatCells(cells), formatDigits(getDigitsFromMask(mask)), line_als.house, line_als, box_als.house, box_als,
+		formatDigits(getDigitsFromMask(line_mask)), line_als.house,
+		formatDigits(getDigitsFromMask(box_mask)), box_als.house)
+	step.Houses = append(step.Houses, line_als.house, box_als.house)
+	step.Cells = append(step.Cells, cells...)
+	step.Cells = append(step.Cells, line_als.cells...)
+	step.Cells = append(step.Cells, box_als.cells...)
+
+	for _, cell := range line_als.house.Cells {
+		if !cellsContain(cells, cell) && !cellsContain(line_als.cells, cell) {
+			for _, digit := range getDigitsFromMask(line_mask) {
+				step.eliminate(cell, digit)
+			}
+		}
+	}
+
+	for _, cell := range box_als.house.Cells {
+		if !cellsContain(cells, cell) && !cellsContain(box_als.cells, cell) {
+			for _, digit := range getDigitsFromMask(box_mask) {
+				step.eliminate(cell, digit)
+			}
+		}
+	}
+
+	if !step.hasChanges() {
+		return nil
+	}
+
+	return step
+}
+
+func SueDeCoq(grid *sudoku.Grid) (*Step, error) {
+	sets := grid.GetSets()
+
+	for _, box := range sets[18:] {
+		for _, line := range sets[:18] {
+			if step := findSueDeCoq(line, box); step != nil {
+				return step, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
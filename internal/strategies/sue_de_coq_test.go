@@ -0,0 +1,152 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestSueDeCoq(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||       |     3 |     3 ||       |       |       ||
+||  (2)  |       |  (7)  || 4 5 6 | 4 5   | 4   6 ||     6 |  (1)  |       ||
+||       |     9 |       ||       |     9 |     9 ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       | 1     ||       |       |       ||
+||  (8)  |   5   |   5 6 ||  (7)  |  (2)  |     6 ||  (4)  |     6 |  (3)  ||
+||       |     9 |     9 ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     || 1     | 1   3 |       ||       |       |       ||
+||       |  (4)  |     6 ||     6 |       |  (8)  ||  (2)  |  (5)  |  (7)  ||
+||       |       |     9 ||       |     9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     | 1     ||       |       |       ||
+||  (6)  |       |  (3)  ||  (2)  | 4     | 4     ||       |  (7)  |  (5)  ||
+||       |   8 9 |       ||       |     9 |     9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |   2   ||       | 1     |       ||       |       |       ||
+||  (5)  |       |       ||  (8)  |     6 |  (7)  ||     6 |  (3)  |  (4)  ||
+||       |     9 |     9 ||       |     9 |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |   2   |   2   ||
+||  (7)  |       |  (4)  ||  (3)  |     6 |  (5)  ||  (1)  |     6 |       ||
+||       |   8 9 |       ||       |     9 |       ||       |     9 |   8 9 ||
+##=======================##=======================##=======================##
+|| 1   3 |   2 3 |   2   || 1     |       | 1   3 ||       |       | 1     ||
+|| 4     |   5   |   5   || 4 5 6 |  (8)  | 4   6 ||  (7)  | 4     |       ||
+||       |       |       ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       | 1   3 ||     3 |   2   | 1 2   ||
+||  (9)  |  (6)  |  (8)  || 4 5   |  (7)  | 4     ||   5   | 4     |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     ||       | 1   3 |       ||     3 |       |       ||
+|| 4     |  (7)  |   5   ||  (9)  | 4 5   |  (2)  ||   5   |  (8)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||       |     3 |     3 ||       |       |       ||
+||  (2)  |       |  (7)  || 4 5 6 | 4 5   | 4   6 ||     6 |  (1)  |       ||
+||       |     9 |       ||       |     9 |     9 ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |       | 1     ||       |       |       ||
+||  (8)  |   5   |   5 6 ||  (7)  |  (2)  |     6 ||  (4)  |     6 |  (3)  ||
+||       |       |       ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     || 1     |     3 |       ||       |       |       ||
+||       |  (4)  |     6 ||     6 |       |  (8)  ||  (2)  |  (5)  |  (7)  ||
+||       |       |     9 ||       |     9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     | 1     ||       |       |       ||
+||  (6)  |       |  (3)  ||  (2)  | 4     | 4     ||       |  (7)  |  (5)  ||
+||       |   8 9 |       ||       |     9 |     9 ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |   2   ||       | 1     |       ||       |       |       ||
+||  (5)  |       |       ||  (8)  |     6 |  (7)  ||     6 |  (3)  |  (4)  ||
+||       |     9 |     9 ||       |     9 |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |   2   |   2   ||
+||  (7)  |       |  (4)  ||  (3)  |     6 |  (5)  ||  (1)  |     6 |       ||
+||       |   8 9 |       ||       |     9 |       ||       |     9 |   8 9 ||
+##=======================##=======================##=======================##
+|| 1   3 |   2 3 |   2   || 1     |       | 1   3 ||       |       | 1     ||
+|| 4     |   5   |   5   || 4 5 6 |  (8)  | 4   6 ||  (7)  | 4     |       ||
+||       |       |       ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       | 1   3 ||     3 |   2   | 1 2   ||
+||  (9)  |  (6)  |  (8)  || 4 5   |  (7)  | 4     ||   5   | 4     |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     ||       | 1   3 |       ||     3 |       |       ||
+|| 4     |  (7)  |   5   ||  (9)  | 4 5   |  (2)  ||   5   |  (8)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := SueDeCoq(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Sue de Coq: intersection r3c1, r3c3 {1, 3, 6, 9}, row 3 ALS r3c4 {1, 6}, box 1 ALS r1c2 {3, 9}; {1, 6} locked in row 3, {3, 9} locked in box 1 => r3c5<>1, r2c2<>9, r2c3<>9")
+}
+
+func TestSueDeCoqNothingToEliminate(t *testing.T) {
+	// The intersection r5c1, r5c2 {3, 4, 5, 9} pairs with r5c9 {3, 4} in row 5
+	// and r4c2 {5, 9} in box 4, but no other cell of row 5 holds 3 or 4 and no
+	// other cell of box 4 holds 5 or 9.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |     3 |       ||   2 3 |       |       ||       |       |     3 ||
+||   5   |   5 6 |  (4)  ||     6 |  (1)  |  (9)  ||  (8)  |  (7)  |   5 6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |     3 |       ||   2 3 |     3 |     3 ||       |       |     3 ||
+||   5   |   5 6 |  (9)  ||     6 |     6 |     6 ||  (4)  |  (1)  |   5 6 ||
+|| 7     | 7     |       ||   8   |   8   |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       |       ||       |     3 |       ||
+||  (8)  |     6 |  (1)  ||  (5)  |  (4)  |  (7)  ||     6 |       |  (2)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |     3 ||       |       |     3 ||
+||  (1)  |   5   |  (2)  ||     6 |     6 | 4   6 ||  (7)  |   5   | 4   6 ||
+||       |     9 |       ||   8   |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |     3 ||
+||   5   | 4 5   |  (6)  ||  (7)  |  (2)  |  (1)  ||   5   |  (8)  | 4     ||
+||       |     9 |       ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |     3 ||   2   |   2 3 |       ||
+||       | 4     |  (8)  ||  (9)  |  (5)  | 4   6 ||     6 |       |  (1)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       |     3 |   2 3 ||   2   |       |       ||
+||     6 |  (8)  |   5   ||  (1)  |     6 |     6 ||   5   |  (4)  |  (7)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |     3 |       ||       |       |       ||
+||  (4)  |  (2)  |       ||       |       |  (5)  ||  (1)  |  (6)  |  (9)  ||
+||       |       | 7     ||   8   | 7 8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |   2   |       ||
+||     6 |  (1)  |   5   ||  (4)  |     6 |     6 ||  (3)  |   5   |  (8)  ||
+||     9 |       | 7     ||       | 7   9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := SueDeCoq(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
@@ -12,18 +12,26 @@ func AssertGridEqualsWithPrettyString(t *testing.T, actual_grid *sudoku.Grid, ex
 	test_utils.AssertNoError(t, expected_grid.LoadPrettyString(expected_pretty_string))
 
 	if !actual_grid.Equals(expected_grid) {
-		t.Errorf("unexpected grid")
+		t.Errorf("unexpected grid:\n%s", actual_grid.PrettyString())
 	}
 }
 
-func AssertChanged(t *testing.T, changed bool) {
-	if !changed {
+func AssertChanged(t *testing.T, step *Step) {
+	if step == nil {
 		t.Errorf("missing change")
 	}
 }
 
-func AssertNoChanged(t *testing.T, changed bool) {
-	if changed {
+func AssertNoChanged(t *testing.T, step *Step) {
+	if step != nil {
 		t.Errorf("unexpected change")
 	}
 }
+
+func AssertStepString(t *testing.T, step *Step, expected string) {
+	if step == nil {
+		t.Errorf("missing step. expected: %s", expected)
+	} else if step.String() != expected {
+		t.Errorf("unexpected step. expected: %s, actual: %s", expected, step)
+	}
+}
@@ -0,0 +1,369 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+// uniqueRectangle holds four cells spanning two rows, two columns and two
+// boxes, all holding both digits. In a puzzle with a single solution the
+// cells cannot end up holding only those two digits, as they could be
+// swapped. The cells are ordered so that cells[i] shares its row with
+// cells[i^1], its column with cells[i^2] and is diagonal to cells[3-i].
+type uniqueRectangle struct {
+	cells  [4]*sudoku.Cell
+	digits [2]int
+	mask   int
+}
+
+func getUniqueRectangles(grid *sudoku.Grid) []*uniqueRectangle {
+	unique_rectangles := []*uniqueRectangle{}
+
+	for _, rows := range getCombinations(9, 2) {
+		for _, columns := range getCombinations(9, 2) {
+			same_band := rows[0]/3 == rows[1]/3
+			same_stack := columns[0]/3 == columns[1]/3
+			if same_band == same_stack {
+				continue
+			}
+
+			cells := [4]*sudoku.Cell{}
+			mask := 0x1ff
+			for i := 0; i < 4; i++ {
+				cells[i], _ = grid.GetCell(rows[i/2]+1, columns[i%2]+1)
+				if cells[i].GetValue() != sudoku.Empty {
+					mask = 0
+					break
+				}
+				mask &= getPencilMarkMask(cells[i])
+			}
+
+			for _, digits := range getCombinations(countDigitsInMask(mask), 2) {
+				common_digits := getDigitsFromMask(mask)
+				a := common_digits[digits[0]]
+				b := common_digits[digits[1]]
+
+				unique_rectangles = append(unique_rectangles, &uniqueRectangle{cells, [2]int{a, b}, 1<<(a-1) | 1<<(b-1)})
+			}
+		}
+	}
+
+	return unique_rectangles
+}
+
+func (u *uniqueRectangle) newStep(technique string, reason string) *Step {
+	step := newStep(technique)
+	step.Description = fmt.Sprintf("%s in %s; %s", formatDigits(u.digits[:]), formatCells(u.cells[:]), reason)
+	step.AssumesUniqueness = true
+	step.Cells = append(step.Cells, u.cells[:]...)
+
+	return step
+}
+
+func (u *uniqueRectangle) isFloor(i int) bool {
+	return getPencilMarkMask(u.cells[i]) == u.mask
+}
+
+// getFloor returns the indices of the cells holding only the two digits and
+// of the rest, the roof.
+func (u *uniqueRectangle) getFloor() ([]int, []int) {
+	floor := []int{}
+	roof := []int{}
+	for i := 0; i < 4; i++ {
+		if u.isFloor(i) {
+			floor = append(floor, i)
+		} else {
+			roof = append(roof, i)
+		}
+	}
+
+	return floor, roof
+}
+
+func (u *uniqueRectangle) getCells(indices []int) []*sudoku.Cell {
+	cells := []*sudoku.Cell{}
+	for _, i := range indices {
+		cells = append(cells, u.cells[i])
+	}
+
+	return cells
+}
+
+// getSharedHouses returns the houses holding both cells.
+func getSharedHouses(sets [27]*sudoku.Set, cell *sudoku.Cell, other_cell *sudoku.Cell) []*sudoku.Set {
+	houses := []*sudoku.Set{}
+	for _, set := range sets {
+		if cellsContain(set.Cells[:], cell) && cellsContain(set.Cells[:], other_cell) {
+			houses = append(houses, set)
+		}
+	}
+
+	return houses
+}
+
+// isConjugatePair reports whether the digit is only found in the two cells in
+// the house.
+func isConjugatePair(house *sudoku.Set, cell *sudoku.Cell, other_cell *sudoku.Cell, digit int) bool {
+	cells := getCellsWithPencilMark(house.Cells[:], digit)
+	return len(cells) == 2 && cellsContain(cells, cell) && cellsContain(cells, other_cell)
+}
+
+func findUniqueRectangleType1(grid *sudoku.Grid, u *uniqueRectangle) *Step {
+	floor, roof := u.getFloor()
+	if len(floor) != 3 {
+		return nil
+	}
+
+	cell := u.cells[roof[0]]
+	step := u.newStep("Unique Rectangle Type 1", fmt.Sprintf("%s is the only cell with other candidates", cell))
+	step.eliminate(cell, u.digits[0])
+	step.eliminate(cell, u.digits[1])
+
+	return step
+}
+
+// findUniqueRectangleExtraDigit covers types 2 and 5, where every roof cell
+// has the same single extra digit, so one of them holds it.
+func findUniqueRectangleExtraDigit(grid *sudoku.Grid, u *uniqueRectangle, technique string, adjacent_roof bool) *Step {
+	floor, roof := u.getFloor()
+	if len(floor) == 0 || len(roof) < 2 {
+		return nil
+	}
+
+	if adjacent_roof != (len(roof) == 2 && roof[0]+roof[1] != 3) {
+		return nil
+	}
+
+	extra_mask := getPencilMarkMask(u.cells[roof[0]]) &^ u.mask
+	if countDigitsInMask(extra_mask) != 1 {
+		return nil
+	}
+
+	for _, i := range roof[1:] {
+		if getPencilMarkMask(u.cells[i])&^u.mask != extra_mask {
+			return nil
+		}
+	}
+
+	extra_digit := getDigitsFromMask(extra_mask)[0]
+	roof_cells := u.getCells(roof)
+
+	step := u.newStep(technique, fmt.Sprintf("one of %s holds %d", formatCells(roof_cells), extra_digit))
+	eliminateFromCellsSeeingAll(step, grid, roof_cells, extra_digit)
+
+	if !step.hasChanges() {
+		return nil
+	}
+
+	return step
+}
+
+func findUniqueRectangleType2(grid *sudoku.Grid, u *uniqueRectangle) *Step {
+	return findUniqueRectangleExtraDigit(grid, u, "Unique Rectangle Type 2", true)
+}
+
+// findUniqueRectangleType3 treats the extra digits of two adjacent roof cells
+// as one cell, which forms a naked subset with other cells of a shared house.
+func findUniqueRectangleType3(grid *sudoku.Grid, u *uniqueRectangle) *Step {
+	floor, roof := u.getFloor()
+	if len(floor) != 2 || roof[0]+roof[1] == 3 {
+		return nil
+	}
+
+	roof_cells := u.getCells(roof)
+	extra_mask := (getPencilMarkMask(roof_cells[0]) | getPencilMarkMask(roof_cells[1])) &^ u.mask
+
+	for _, house := range getSharedHouses(grid.GetSets(), roof_cells[0], roof_cells[1]) {
+		other_cells := []*sudoku.Cell{}
+		for _, cell := range house.Cells {
+			if cell.GetValue() == sudoku.Empty && !cellsContain(roof_cells, cell) {
+				other_cells = append(other_cells, cell)
+			}
+		}
+
+		for size := 1; size <= 3; size++ {
+			for _, combination := range getCombinations(len(other_cells), size) {
+				subset_cells := []*sudoku.Cell{}
+				subset_mask := extra_mask
+				for _, i := range combination {
+					subset_cells = append(subset_cells, other_cells[i])
+					subset_mask |= getPencilMarkMask(other_cells[i])
+				}
+
+				if countDigitsInMask(subset_mask) != size+1 {
+					continue
+				}
+
+				subset_digits := getDigitsFromMask(subset_mask)
+				step := u.newStep("Unique Rectangle Type 3", fmt.Sprintf("extra candidates %s of %s form a naked subset %s with %s in %s", formatDigits(getDigitsFromMask(extra_mask)), formatCells(roof_cells), formatDigits(subset_digits), formatCells(subset_cells), house))
+				step.Houses = append(step.Houses, house)
+
+				for _, cell := range other_cells {
+					if cellsContain(subset_cells, cell) {
+						continue
+					}
+
+					for _, digit := range subset_digits {
+						step.eliminate(cell, digit)
+					}
+				}
+
+				if step.hasChanges() {
+					return step
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// findUniqueRectangleType4 looks for one of the digits being confined to the
+// two adjacent roof cells in a shared house, so the other digit cannot be in
+// either of them.
+func findUniqueRectangleType4(grid *sudoku.Grid, u *uniqueRectangle) *Step {
+	floor, roof := u.getFloor()
+	if len(floor) != 2 || roof[0]+roof[1] == 3 {
+		return nil
+	}
+
+	roof_cells := u.getCells(roof)
+
+	for _, house := range getSharedHouses(grid.GetSets(), roof_cells[0], roof_cells[1]) {
+		for i, digit := range u.digits {
+			if !isConjugatePair(house, roof_cells[0], roof_cells[1], digit) {
+				continue
+			}
+
+			other_digit := u.digits[1-i]
+			step := u.newStep("Unique Rectangle Type 4", fmt.Sprintf("%d in %s is confined to %s, so they cannot hold %d", digit, house, formatCells(roof_cells), other_digit))
+			step.Houses = append(step.Houses, house)
+			step.eliminate(roof_cells[0], other_digit)
+			step.eliminate(roof_cells[1], other_digit)
+
+			return step
+		}
+	}
+
+	return nil
+}
+
+func findUniqueRectangleType5(grid *sudoku.Grid, u *uniqueRectangle) *Step {
+	return findUniqueRectangleExtraDigit(grid, u, "Unique Rectangle Type 5", false)
+}
+
+// findUniqueRectangleType6 needs two diagonal floor cells and one of the
+// digits forming conjugate pairs in both rows or both columns. That digit
+// then has to go into the floor cells, as the roof cells holding it would
+// leave the floor cells with the other digit only.
+func findUniqueRectangleType6(grid *sudoku.Grid, u *uniqueRectangle) *Step {
+	floor, roof := u.getFloor()
+	if len(floor) != 2 || roof[0]+roof[1] != 3 {
+		return nil
+	}
+
+	sets := grid.GetSets()
+	roof_cells := u.getCells(roof)
+
+	for _, digit := range u.digits {
+		for _, lines := range [][2][2]int{{{0, 1}, {2, 3}}, {{0, 2}, {1, 3}}} {
+			houses := []*sudoku.Set{}
+
+			for _, line := range lines {
+				cell := u.cells[line[0]]
+				other_cell := u.cells[line[1]]
+				house := getCommonLine(sets, []*sudoku.Cell{cell, other_cell})
+
+				if isConjugatePair(house, cell, other_cell, digit) {
+					houses = append(houses, house)
+				}
+			}
+
+			if len(houses) != 2 {
+				continue
+			}
+
+			step := u.newStep("Unique Rectangle Type 6", fmt.Sprintf("%d forms conjugate pairs in %s and %s, so %s cannot hold it", digit, houses[0], houses[1], formatCells(roof_cells)))
+			step.Houses = append(step.Houses, houses...)
+			step.eliminate(roof_cells[0], digit)
+			step.eliminate(roof_cells[1], digit)
+
+			return step
+		}
+	}
+
+	return nil
+}
+
+// findHiddenUniqueRectangle takes a floor cell and its diagonal cell. If one
+// of the digits forms conjugate pairs along both the row and the column of
+// the diagonal cell, that cell cannot hold the other digit.
+func findHiddenUniqueRectangle(grid *sudoku.Grid, u *uniqueRectangle) *Step {
+	sets := grid.GetSets()
+
+	for i := 0; i < 4; i++ {
+		if !u.isFloor(i) || u.isFloor(3-i) {
+			continue
+		}
+
+		cell := u.cells[3-i]
+		row_cell := u.cells[(3-i)^1]
+		column_cell := u.cells[(3-i)^2]
+		row := sets[cell.GetRowId()-1]
+		column := sets[cell.GetColumnId()-1+9]
+
+		for j, digit := range u.digits {
+			if !isConjugatePair(row, cell, row_cell, digit) || !isConjugatePair(column, cell, column_cell, digit) {
+				continue
+			}
+
+			other_digit := u.digits[1-j]
+			step := u.newStep("Hidden Unique Rectangle", fmt.Sprintf("%d forms conjugate pairs in %s and %s, so %s cannot hold %d", digit, row, column, cell, other_digit))
+			step.Houses = append(step.Houses, row, column)
+			step.eliminate(cell, other_digit)
+
+			return step
+		}
+	}
+
+	return nil
+}
+
+func findUniqueRectangle(grid *sudoku.Grid, find func(grid *sudoku.Grid, u *uniqueRectangle) *Step) (*Step, error) {
+	for _, unique_rectangle := range getUniqueRectangles(grid) {
+		if step := find(grid, unique_rectangle); step != nil && step.hasChanges() {
+			return step, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func UniqueRectangleType1(grid *sudoku.Grid) (*Step, error) {
+	return findUniqueRectangle(grid, findUniqueRectangleType1)
+}
+
+func UniqueRectangleType2(grid *sudoku.Grid) (*Step, error) {
+	return findUniqueRectangle(grid, findUniqueRectangleType2)
+}
+
+func UniqueRectangleType3(grid *sudoku.Grid) (*Step, error) {
+	return findUniqueRectangle(grid, findUniqueRectangleType3)
+}
+
+func UniqueRectangleType4(grid *sudoku.Grid) (*Step, error) {
+	return findUniqueRectangle(grid, findUniqueRectangleType4)
+}
+
+func UniqueRectangleType5(grid *sudoku.Grid) (*Step, error) {
+	return findUniqueRectangle(grid, findUniqueRectangleType5)
+}
+
+func UniqueRectangleType6(grid *sudoku.Grid) (*Step, error) {
+	return findUniqueRectangle(grid, findUniqueRectangleType6)
+}
+
+func HiddenUniqueRectangle(grid *sudoku.Grid) (*Step, error) {
+	return findUniqueRectangle(grid, findHiddenUniqueRectangle)
+}
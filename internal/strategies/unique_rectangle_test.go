@@ -0,0 +1,1010 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestUniqueRectangleType1(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2   |       |       ||       |       |       ||       |       |   2   ||
+||     6 |  (5)  |     6 || 4     | 4     |  (3)  ||  (7)  |  (1)  |       ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |       |       ||       |       |       ||       |       |   2 3 ||
+||       |  (4)  |  (7)  ||  (8)  |  (6)  |  (1)  ||  (9)  |  (5)  |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||       |       |       ||       |     3 |       ||
+||  (9)  |  (1)  |       ||  (2)  |  (7)  |  (5)  ||  (6)  |       |  (4)  ||
+||       |       |   8   ||       |       |       ||       |   8   |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (3)  |  (9)  ||  (5)  |  (8)  |  (2)  ||  (4)  |  (6)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       | 1     |       ||       |   2   |       ||
+||     6 |     6 | 4   6 ||  (3)  | 4     |  (7)  ||  (8)  |       |  (5)  ||
+||       |       |       ||       |     9 |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   | 1     ||       | 1     |       ||       |   2   |       ||
+||  (5)  |       | 4     || 4     | 4     |  (6)  ||  (3)  |       |  (7)  ||
+||       |   8   |   8   ||     9 |     9 |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 ||       |       |       ||       |       |     3 ||
+||     6 |  (9)  |     6 ||  (7)  |  (5)  |  (8)  ||  (2)  |  (4)  |     6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (4)  |     6 |  (2)  ||  (1)  |  (3)  |  (9)  ||  (5)  |  (7)  |     6 ||
+||       |   8   |       ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |       |       ||       |     3 |       ||
+||       |  (7)  |  (5)  ||  (6)  |  (2)  |  (4)  ||  (1)  |       |  (9)  ||
+||   8   |       |       ||       |       |       ||       |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||   2   |       |       ||       |       |       ||       |       |   2   ||
+||     6 |  (5)  |     6 || 4     | 4     |  (3)  ||  (7)  |  (1)  |       ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |       |       ||       |       |       ||       |       |   2 3 ||
+||       |  (4)  |  (7)  ||  (8)  |  (6)  |  (1)  ||  (9)  |  (5)  |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||       |       |       ||       |     3 |       ||
+||  (9)  |  (1)  |       ||  (2)  |  (7)  |  (5)  ||  (6)  |       |  (4)  ||
+||       |       |   8   ||       |       |       ||       |   8   |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (3)  |  (9)  ||  (5)  |  (8)  |  (2)  ||  (4)  |  (6)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       | 1     |       ||       |   2   |       ||
+||     6 |     6 | 4   6 ||  (3)  | 4     |  (7)  ||  (8)  |       |  (5)  ||
+||       |       |       ||       |     9 |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   | 1     ||       | 1     |       ||       |   2   |       ||
+||  (5)  |       | 4     || 4     |       |  (6)  ||  (3)  |       |  (7)  ||
+||       |   8   |   8   ||     9 |       |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 ||       |       |       ||       |       |     3 ||
+||     6 |  (9)  |     6 ||  (7)  |  (5)  |  (8)  ||  (2)  |  (4)  |     6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (4)  |     6 |  (2)  ||  (1)  |  (3)  |  (9)  ||  (5)  |  (7)  |     6 ||
+||       |   8   |       ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |       |       ||       |     3 |       ||
+||       |  (7)  |  (5)  ||  (6)  |  (2)  |  (4)  ||  (1)  |       |  (9)  ||
+||   8   |       |       ||       |       |       ||       |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType1(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Unique Rectangle Type 1: {4, 9} in r1c4, r1c5, r6c4, r6c5; r6c5 is the only cell with other candidates (assuming a unique solution) => r6c5<>4, r6c5<>9")
+}
+
+func TestUniqueRectangleType2(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1     |       | 1     ||       |       |       ||       | 1   3 | 1   3 ||
+||       |  (4)  |     6 ||  (5)  |  (7)  |  (9)  ||  (2)  |     6 |       ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     || 1 2   |       |   2   ||       |       |       ||
+||  (3)  |   5 6 |   5 6 ||     6 | 4   6 | 4     ||   5 6 |  (8)  |  (9)  ||
+||       | 7     | 7     ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     || 1     |       |       ||       | 1     |       ||
+||  (9)  |  (2)  |   5 6 ||     6 |  (8)  |  (3)  ||  (4)  |   5 6 |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       | 1     | 1     ||
+||  (7)  |   5 6 |  (3)  ||  (4)  |  (2)  |     6 ||   5 6 |   5 6 |       ||
+||       |     9 |       ||       |       |   8   ||     9 |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||  (4)  |  (1)  |  (2)  ||  (9)  |  (5)  |     6 ||  (7)  |     6 |       ||
+||       |       |       ||       |       |   8   ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |   5 6 |   5 6 ||  (3)  |  (1)  |  (7)  ||   5 6 |  (4)  |  (2)  ||
+||   8   |   8 9 |   8 9 ||       |       |       ||     9 |       |       ||
+##=======================##=======================##=======================##
+||   2   |       |       ||   2   |     3 |       ||     3 |   2   |       ||
+||   5   |   5   | 4 5   ||       | 4     |  (1)  ||       |       |  (6)  ||
+||       |     9 |     9 || 7 8   |       |       ||   8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |   2   |       ||
+||  (6)  |  (3)  |       ||       |  (9)  |  (5)  ||  (1)  |       |  (4)  ||
+||       |       | 7 8   || 7 8   |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   |       | 1     ||       |     3 |   2   ||     3 |       |       ||
+||       |       | 4     ||     6 |     6 | 4     ||       |  (9)  |  (5)  ||
+||       | 7 8   |       || 7 8   |       |       ||   8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1     |       | 1     ||       |       |       ||       | 1   3 | 1   3 ||
+||       |  (4)  |     6 ||  (5)  |  (7)  |  (9)  ||  (2)  |     6 |       ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     || 1 2   |       |   2   ||       |       |       ||
+||  (3)  |   5 6 |   5 6 ||     6 | 4   6 | 4     ||   5 6 |  (8)  |  (9)  ||
+||       | 7     | 7     ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     || 1     |       |       ||       | 1     |       ||
+||  (9)  |  (2)  |   5 6 ||     6 |  (8)  |  (3)  ||  (4)  |   5 6 |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       | 1     | 1     ||
+||  (7)  |   5 6 |  (3)  ||  (4)  |  (2)  |     6 ||   5 6 |   5 6 |       ||
+||       |     9 |       ||       |       |   8   ||     9 |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||  (4)  |  (1)  |  (2)  ||  (9)  |  (5)  |     6 ||  (7)  |     6 |       ||
+||       |       |       ||       |       |   8   ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |   5 6 |   5 6 ||  (3)  |  (1)  |  (7)  ||   5 6 |  (4)  |  (2)  ||
+||   8   |   8 9 |   8 9 ||       |       |       ||     9 |       |       ||
+##=======================##=======================##=======================##
+||   2   |       |       ||   2   |     3 |       ||     3 |   2   |       ||
+||   5   |   5   | 4 5   ||       | 4     |  (1)  ||       |       |  (6)  ||
+||       |     9 |     9 || 7 8   |       |       ||   8   | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |   2   |       ||
+||  (6)  |  (3)  |       ||       |  (9)  |  (5)  ||  (1)  |       |  (4)  ||
+||       |       | 7 8   || 7 8   |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   |       | 1     ||       |     3 |   2   ||     3 |       |       ||
+||       |       | 4     ||     6 |     6 | 4     ||       |  (9)  |  (5)  ||
+||       | 7 8   |       || 7     |       |       ||   8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType2(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Unique Rectangle Type 2: {2, 7} in r7c4, r7c8, r8c4, r8c8; one of r7c4, r8c4 holds 8 (assuming a unique solution) => r9c4<>8")
+}
+
+func TestUniqueRectangleType3(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||   2   |   2   |       ||
+||  (1)  |  (7)  |  (8)  ||  (5)  |  (3)  |  (6)  || 4     | 4     |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (6)  |  (2)  ||  (8)  |  (4)  |  (7)  ||  (1)  |  (5)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       |       |       ||       |       |       ||
+|| 4     |  (5)  | 4     ||  (1)  |  (2)  |  (9)  ||       |       |  (6)  ||
+||       |       |       ||       |       |       || 7 8   | 7 8   |       ||
+##=======================##=======================##=======================##
+||       |   2 3 | 1     ||   2   | 1     | 1 2 3 ||   2 3 | 1 2   |       ||
+||  (5)  | 4     |     6 || 4   6 |       |       ||       |     6 |  (8)  ||
+||       |       |       ||     9 |     9 |       || 7   9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2 3 |       ||   2   |       | 1 2 3 ||   2 3 | 1 2   |       ||
+||  (8)  |       |  (7)  ||     6 |  (5)  |       ||       |     6 |  (4)  ||
+||       |       |       ||     9 |       |       ||     9 |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       | 1     ||   2   |       |       ||   2 3 | 1 2   |       ||
+|| 4     |  (9)  |     6 || 4   6 |  (7)  |  (8)  ||       |     6 |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |     3 |     3 ||       |       |       ||       |       |       ||
+||  (2)  |       |       ||  (7)  |  (6)  |  (4)  ||  (5)  |       |  (1)  ||
+||       |   8   |     9 ||       |       |       ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (1)  | 4     ||  (3)  |       |  (5)  ||  (6)  | 4     |  (2)  ||
+||       |       |     9 ||       |   8 9 |       ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   | 1     | 1 2   ||       |       |       ||
+||  (6)  | 4     |  (5)  ||       |       |       || 4     |  (3)  |  (7)  ||
+||       |   8   |       ||     9 |   8 9 |       ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||   2   |   2   |       ||
+||  (1)  |  (7)  |  (8)  ||  (5)  |  (3)  |  (6)  || 4     | 4     |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (6)  |  (2)  ||  (8)  |  (4)  |  (7)  ||  (1)  |  (5)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       |       |       ||       |       |       ||
+|| 4     |  (5)  | 4     ||  (1)  |  (2)  |  (9)  ||       |       |  (6)  ||
+||       |       |       ||       |       |       || 7 8   | 7 8   |       ||
+##=======================##=======================##=======================##
+||       |   2 3 | 1     ||   2   | 1     | 1 2 3 ||   2 3 | 1 2   |       ||
+||  (5)  | 4     |     6 || 4   6 |       |       ||       |     6 |  (8)  ||
+||       |       |       ||     9 |     9 |       || 7   9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2 3 |       ||   2   |       | 1 2 3 ||   2 3 | 1     |       ||
+||  (8)  |       |  (7)  ||     6 |  (5)  |       ||       |     6 |  (4)  ||
+||       |       |       ||     9 |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       | 1     ||   2   |       |       ||   2 3 | 1 2   |       ||
+|| 4     |  (9)  |     6 || 4   6 |  (7)  |  (8)  ||       |     6 |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |     3 |     3 ||       |       |       ||       |       |       ||
+||  (2)  |       |       ||  (7)  |  (6)  |  (4)  ||  (5)  |       |  (1)  ||
+||       |   8   |     9 ||       |       |       ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (1)  | 4     ||  (3)  |       |  (5)  ||  (6)  | 4     |  (2)  ||
+||       |       |     9 ||       |   8 9 |       ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   | 1     | 1 2   ||       |       |       ||
+||  (6)  | 4     |  (5)  ||       |       |       || 4     |  (3)  |  (7)  ||
+||       |   8   |       ||     9 |   8 9 |       ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType3(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Unique Rectangle Type 3: {1, 6} in r4c3, r4c8, r6c3, r6c8; extra candidates {2, 7, 9} of r4c8, r6c8 form a naked subset {2, 3, 7, 9} with r4c7, r5c7, r6c7 in box 6 (assuming a unique solution) => r5c8<>2, r5c8<>9")
+}
+
+func TestUniqueRectangleType4(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |     3 |       ||   2 3 |       |       ||       |       |     3 ||
+||   5   |   5 6 |  (4)  ||     6 |  (1)  |  (9)  ||  (8)  |  (7)  |   5 6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |     3 |       ||   2 3 |     3 |     3 ||       |       |     3 ||
+||   5   |   5 6 |  (9)  ||     6 |     6 |     6 ||  (4)  |  (1)  |   5 6 ||
+|| 7     | 7     |       ||   8   |   8   |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       |       ||       |     3 |       ||
+||  (8)  |     6 |  (1)  ||  (5)  |  (4)  |  (7)  ||     6 |       |  (2)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |     3 ||       |       |     3 ||
+||  (1)  |   5   |  (2)  ||     6 |     6 | 4   6 ||  (7)  |   5   | 4   6 ||
+||       |     9 |       ||   8   |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |     3 ||
+||   5   | 4 5   |  (6)  ||  (7)  |  (2)  |  (1)  ||   5   |  (8)  | 4     ||
+||       |     9 |       ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |     3 ||   2   |   2 3 |       ||
+||       | 4     |  (8)  ||  (9)  |  (5)  | 4   6 ||     6 |       |  (1)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       |     3 |   2 3 ||   2   |       |       ||
+||     6 |  (8)  |   5   ||  (1)  |     6 |     6 ||   5   |  (4)  |  (7)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |     3 |       ||       |       |       ||
+||  (4)  |  (2)  |       ||       |       |  (5)  ||  (1)  |  (6)  |  (9)  ||
+||       |       | 7     ||   8   | 7 8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |   2   |       ||
+||     6 |  (1)  |   5   ||  (4)  |     6 |     6 ||  (3)  |   5   |  (8)  ||
+||     9 |       | 7     ||       | 7   9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |     3 |       ||   2 3 |       |       ||       |       |     3 ||
+||   5   |   5 6 |  (4)  ||     6 |  (1)  |  (9)  ||  (8)  |  (7)  |   5 6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |     3 |       ||   2 3 |     3 |     3 ||       |       |     3 ||
+||   5   |   5 6 |  (9)  ||     6 |     6 |     6 ||  (4)  |  (1)  |   5 6 ||
+|| 7     | 7     |       ||   8   |   8   |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       |       ||       |     3 |       ||
+||  (8)  |     6 |  (1)  ||  (5)  |  (4)  |  (7)  ||     6 |       |  (2)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |     3 ||       |       |     3 ||
+||  (1)  |   5   |  (2)  ||     6 |     6 | 4   6 ||  (7)  |   5   | 4   6 ||
+||       |     9 |       ||   8   |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |     3 ||
+||   5   | 4 5   |  (6)  ||  (7)  |  (2)  |  (1)  ||   5   |  (8)  | 4     ||
+||       |     9 |       ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |     3 ||   2   |   2 3 |       ||
+||       | 4     |  (8)  ||  (9)  |  (5)  | 4   6 ||     6 |       |  (1)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       |     3 |   2 3 ||   2   |       |       ||
+||     6 |  (8)  |   5   ||  (1)  |       |     6 ||   5   |  (4)  |  (7)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |     3 |       ||       |       |       ||
+||  (4)  |  (2)  |       ||       |       |  (5)  ||  (1)  |  (6)  |  (9)  ||
+||       |       | 7     ||   8   | 7 8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |   2   |       ||
+||     6 |  (1)  |   5   ||  (4)  |       |     6 ||  (3)  |   5   |  (8)  ||
+||     9 |       | 7     ||       | 7   9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType4(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Unique Rectangle Type 4: {6, 9} in r7c1, r7c5, r9c1, r9c5; 9 in column 5 is confined to r7c5, r9c5, so they cannot hold 6 (assuming a unique solution) => r7c5<>6, r9c5<>6")
+}
+
+func TestUniqueRectangleType5(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+||       | 4 5 6 | 4 5 6 ||       | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+||       | 7 8 9 | 7 8 9 ||       | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2   | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+||       | 4 5 6 | 4 5 6 ||       | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+||       | 7 8 9 | 7 8 9 ||       | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2   | 1 2   || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+||       | 4 5 6 | 4 5 6 ||       | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+||       | 7 8 9 | 7 8 9 ||       | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2   | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+||       | 4 5 6 | 4 5 6 ||       | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+||       | 7 8 9 | 7 8 9 ||       | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 || 1 2 3 | 1 2 3 | 1 2 3 ||
+|| 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 || 4 5 6 | 4 5 6 | 4 5 6 ||
+|| 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 || 7 8 9 | 7 8 9 | 7 8 9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType5(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Unique Rectangle Type 5: {1, 2} in r1c1, r1c4, r2c1, r2c4; one of r1c1, r1c4, r2c1 holds 3 (assuming a unique solution) => r1c2<>3, r1c3<>3")
+}
+
+func TestUniqueRectangleType6(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||       |     3 |     3 ||       |       |       ||
+||  (2)  |       |  (7)  || 4 5 6 | 4 5   | 4   6 ||     6 |  (1)  |       ||
+||       |     9 |       ||       |     9 |     9 ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     | 1     ||       |       | 1     ||       |       |       ||
+||  (8)  |   5   |   5 6 ||  (7)  |  (2)  |     6 ||  (4)  |     6 |  (3)  ||
+||       |     9 |     9 ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     || 1     | 1   3 |       ||       |       |       ||
+||       |  (4)  |     6 ||     6 |       |  (8)  ||  (2)  |  (5)  |  (7)  ||
+||       |       |     9 ||       |     9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     | 1     ||       |       |       ||
+||  (6)  |       |  (3)  ||  (2)  | 4     | 4     ||       |       |  (5)  ||
+||       |   8 9 |       ||       |     9 |     9 || 7 8 9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   ||       | 1     |       ||       |       |       ||
+||   5   |   5   |   5   ||  (8)  |     6 |  (7)  ||     6 |  (3)  |  (4)  ||
+||       |     9 |     9 ||       |     9 |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |   2   |   2   ||
+||  (7)  |       |  (4)  ||  (3)  |     6 |  (5)  ||  (1)  |     6 |       ||
+||       |   8 9 |       ||       |     9 |       ||       |     9 |   8 9 ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2 3 | 1 2   || 1     |       | 1   3 ||       |       | 1     ||
+|| 4 5   |   5   |   5   || 4 5 6 |  (8)  | 4   6 ||       | 4     |       ||
+||       |       |       ||       |       |       || 7   9 | 7   9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       | 1   3 ||     3 |   2   | 1 2   ||
+||  (9)  |  (6)  |  (8)  || 4 5   |  (7)  | 4     ||   5   | 4     |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     ||       | 1   3 |       ||     3 |       |       ||
+|| 4 5   |  (7)  |   5   ||  (9)  | 4 5   |  (2)  ||   5   |  (8)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||       |     3 |     3 ||       |       |       ||
+||  (2)  |       |  (7)  || 4 5 6 | 4 5   | 4   6 ||     6 |  (1)  |       ||
+||       |     9 |       ||       |     9 |     9 ||   8 9 |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     | 1     ||       |       | 1     ||       |       |       ||
+||  (8)  |   5   |   5 6 ||  (7)  |  (2)  |     6 ||  (4)  |     6 |  (3)  ||
+||       |     9 |     9 ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     || 1     | 1   3 |       ||       |       |       ||
+||       |  (4)  |     6 ||     6 |       |  (8)  ||  (2)  |  (5)  |  (7)  ||
+||       |       |     9 ||       |     9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     | 1     ||       |       |       ||
+||  (6)  |       |  (3)  ||  (2)  | 4     | 4     ||       |       |  (5)  ||
+||       |   8 9 |       ||       |     9 |     9 ||   8 9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   ||       | 1     |       ||       |       |       ||
+||   5   |   5   |   5   ||  (8)  |     6 |  (7)  ||     6 |  (3)  |  (4)  ||
+||       |     9 |     9 ||       |     9 |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |   2   |   2   ||
+||  (7)  |       |  (4)  ||  (3)  |     6 |  (5)  ||  (1)  |     6 |       ||
+||       |   8 9 |       ||       |     9 |       ||       |     9 |   8 9 ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2 3 | 1 2   || 1     |       | 1   3 ||       |       | 1     ||
+|| 4 5   |   5   |   5   || 4 5 6 |  (8)  | 4   6 ||       | 4     |       ||
+||       |       |       ||       |       |       || 7   9 |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       | 1   3 ||     3 |   2   | 1 2   ||
+||  (9)  |  (6)  |  (8)  || 4 5   |  (7)  | 4     ||   5   | 4     |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     ||       | 1   3 |       ||     3 |       |       ||
+|| 4 5   |  (7)  |   5   ||  (9)  | 4 5   |  (2)  ||   5   |  (8)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType6(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Unique Rectangle Type 6: {7, 9} in r4c7, r4c8, r7c7, r7c8; 7 forms conjugate pairs in row 4 and row 7, so r4c7, r7c8 cannot hold it (assuming a unique solution) => r4c7<>7, r7c8<>7")
+}
+
+func TestHiddenUniqueRectangle(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |  (7)  ||
+||     9 |     9 |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4     |  (9)  |  (7)  || 4     | 4 5   |   5   ||
+||       |       |       ||   8   |       |       ||   8   |   8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7     |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |  (7)  ||
+||     9 |     9 |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4     |  (9)  |  (7)  || 4     | 4 5   |   5   ||
+||       |       |       ||   8   |       |       ||   8   |       |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7     |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := HiddenUniqueRectangle(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Hidden Unique Rectangle: {5, 8} in r1c8, r1c9, r6c8, r6c9; 5 forms conjugate pairs in row 6 and column 8, so r6c8 cannot hold 8 (assuming a unique solution) => r6c8<>8")
+}
+
+func TestUniqueRectangleFloorGivens(t *testing.T) {
+	// r1c4 and r1c5 are givens, so r1c4, r1c5, r6c4, r6c5 is no unique
+	// rectangle, even though r6c4 and r6c5 still hold 4 and 9.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2   |       |       ||       |       |       ||       |       |   2   ||
+||     6 |  (5)  |     6 ||  (4)  |  (9)  |  (3)  ||  (7)  |  (1)  |       ||
+||   8   |       |   8   ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |       |       ||       |       |       ||       |       |   2 3 ||
+||       |  (4)  |  (7)  ||  (8)  |  (6)  |  (1)  ||  (9)  |  (5)  |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||       |       |       ||       |     3 |       ||
+||  (9)  |  (1)  |       ||  (2)  |  (7)  |  (5)  ||  (6)  |       |  (4)  ||
+||       |       |   8   ||       |       |       ||       |   8   |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (3)  |  (9)  ||  (5)  |  (8)  |  (2)  ||  (4)  |  (6)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |   2   | 1     ||       | 1     |       ||       |   2   |       ||
+||     6 |     6 | 4   6 ||  (3)  | 4     |  (7)  ||  (8)  |       |  (5)  ||
+||       |       |       ||       |     9 |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   | 1     ||       | 1     |       ||       |   2   |       ||
+||  (5)  |       | 4     || 4     | 4     |  (6)  ||  (3)  |       |  (7)  ||
+||       |   8   |   8   ||     9 |     9 |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 ||       |       |       ||       |       |     3 ||
+||     6 |  (9)  |     6 ||  (7)  |  (5)  |  (8)  ||  (2)  |  (4)  |     6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (4)  |     6 |  (2)  ||  (1)  |  (3)  |  (9)  ||  (5)  |  (7)  |     6 ||
+||       |   8   |       ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |       |       ||       |     3 |       ||
+||       |  (7)  |  (5)  ||  (6)  |  (2)  |  (4)  ||  (1)  |       |  (9)  ||
+||   8   |       |       ||       |       |       ||       |   8   |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType1(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestUniqueRectangleType2DifferentExtraCandidates(t *testing.T) {
+	// {5, 6} in r3c7, r3c8, r8c7, r8c8, but the roof cells r8c7 and r8c8 have
+	// different extra candidates {1} and {1, 9}.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (6)  |  (2)  |  (5)  ||  (9)  |  (7)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (6)  ||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||   5 6 |   5 6 |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+|| 1     |       |   2   || 1 2   |       |   2   || 1     | 1 2   |       ||
+||       |  (3)  |       ||       |  (6)  | 4     ||       | 4     |  (5)  ||
+||     9 |       |   8   ||   8   |       | 7     || 7 8   |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1 2   |     3 |       || 1   3 | 1 2 3 |       ||
+||  (5)  |  (7)  |       ||       | 4     |  (9)  ||       | 4     |  (6)  ||
+||       |       |   8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |     3 |   2   || 1   3 | 1 2 3 |       ||
+||       |  (6)  |  (4)  ||  (5)  |       |       ||       |       |       ||
+||     9 |       |       ||       | 7     | 7 8   || 7 8   |     9 | 7   9 ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       ||       | 1     |       ||
+||  (6)  |       |       ||  (3)  |  (5)  |       ||  (2)  |       |  (4)  ||
+||       |   8   | 7   9 ||       |       | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   |       |   2   || 1     | 1     |       ||
+||  (3)  |       |       ||       | 4     | 4   6 ||   5 6 |   5 6 |  (8)  ||
+||       |       | 7   9 ||     9 | 7     | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||   2   |       |       ||     3 |     3 |       ||
+||  (4)  |       |  (5)  ||       |  (1)  |     6 ||     6 |     6 |       ||
+||       |   8   |       ||   8 9 |       |   8   || 7     |     9 | 7   9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType2(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestUniqueRectangleType3NothingToEliminate(t *testing.T) {
+	// {6, 7} in r7c5, r7c7, r8c5, r8c7: the extra candidates of r7c5 and r8c5
+	// form a naked quad {2, 4, 5, 7} with r2c5, r3c5 and r9c5, but every
+	// other cell of column 5 is solved.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2   |   2   |       ||       |       |     3 ||   2 3 |   2   |       ||
+|| 4     | 4 5   | 4     ||  (6)  |  (1)  |   5   ||   5   |       |  (9)  ||
+||       | 7     | 7 8   ||       |       | 7 8   ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||     3 |       |       || 1   3 | 1     |       ||
+||  (6)  |   5   |       || 4     | 4 5   |  (2)  ||   5   |       |  (7)  ||
+||       |       |   8 9 ||   8 9 |       |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   | 1 2   ||       |       |       || 1 2   |       |       ||
+||  (3)  |   5   |       ||       |   5   |   5   ||   5   |  (6)  |  (4)  ||
+||       | 7     | 7 8 9 ||   8 9 | 7     | 7 8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       | 1 2   | 1 2   ||       |       |       ||       |       | 1 2   ||
+||  (9)  | 4     | 4     ||  (5)  |  (3)  |  (6)  ||  (8)  |  (7)  |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1 2   ||       |       |       || 1 2   |       |       ||
+||  (5)  |  (6)  |       ||  (7)  |  (8)  |  (4)  ||       |  (9)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (8)  |  (3)  ||  (2)  |  (9)  |  (1)  ||  (4)  |  (5)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |   2   |     3 ||       |   2 3 |       ||
+||  (1)  |  (9)  | 4 5   || 4     | 4 5 6 |   5   ||     6 |       |  (8)  ||
+||       |       | 7     ||       | 7     | 7     || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1     |   2   |       ||       |       | 1 2   ||
+||  (8)  |  (3)  |   5   ||       |   5 6 |   5   ||     6 |  (4)  |       ||
+||       |       | 7     ||     9 | 7     | 7   9 || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |   2   |       || 1   3 |   2   |     3 ||       | 1   3 |       ||
+|| 4     | 4     |  (6)  ||       | 4     |       ||  (9)  |       |  (5)  ||
+||       | 7     |       ||   8   | 7     |   8   ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType3(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestUniqueRectangleType4NoConjugatePair(t *testing.T) {
+	// {1, 8} in r2c1, r2c3, r6c1, r6c3, but neither digit is confined to the
+	// roof cells r6c1 and r6c3 in row 6 or box 4.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||     3 |       |     3 ||       |       |       ||       |     3 |       ||
+|| 4     |  (2)  | 4     ||  (5)  |  (8)  |  (6)  ||  (7)  |       |  (1)  ||
+||     9 |       |     9 ||       |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||       |       |       ||
+||       |  (6)  |       ||  (9)  |  (7)  |  (3)  ||  (4)  |  (5)  |  (2)  ||
+||   8   |       |   8   ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 || 1 2   | 1 2   |       ||     3 |     3 |       ||
+||       |   5   |   5   ||       |       |  (4)  ||       |     6 |     6 ||
+|| 7   9 | 7     |     9 ||       |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+||     3 |       |       ||       |     3 |       ||       |     3 |       ||
+||       |  (4)  |  (2)  ||     6 |     6 |       ||  (1)  |       |  (5)  ||
+|| 7 8 9 |       |       || 7 8   |     9 |   8 9 ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 || 1 2   |       |       ||   2 3 |       |       ||
+||  (6)  |       |       ||       |  (4)  |  (5)  ||       |  (7)  |       ||
+||       |   8 9 |       ||       |       |       ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1   3 ||       |     3 | 1 2   ||       |   2 3 |       ||
+||       |   5   |   5   ||       |       |       ||  (6)  |       |  (4)  ||
+|| 7 8 9 | 7     |   8 9 || 7 8   |     9 |       ||       |   8 9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |   2   |       ||       |   2   |       ||
+|| 4     |  (1)  | 4     ||  (3)  |       |  (7)  ||  (5)  |     6 |     6 ||
+||   8 9 |       |   8 9 ||       |     9 |       ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2   | 1 2   ||   2   |       |       ||
+||  (5)  |       |  (7)  ||     6 |     6 |       ||       |  (4)  |  (3)  ||
+||       |   8 9 |       ||   8   |     9 |       ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (6)  ||  (4)  |  (5)  |       ||       |  (1)  |  (7)  ||
+||       |       |       ||       |       |   8 9 ||   8 9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType4(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestUniqueRectangleType5DifferentExtraCandidates(t *testing.T) {
+	// {6, 8} in r5c1, r5c5, r6c1, r6c5 with the diagonal roof cells r5c1 and
+	// r6c5, but they have different extra candidates {3, 5} and {5}.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||     3 |       |       ||       |       |     3 ||       |       |       ||
+||     6 |  (9)  |  (2)  ||  (7)  |  (4)  |       ||  (1)  |  (5)  |     6 ||
+||       |       |       ||       |       |   8   ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5 6 |     6 |  (7)  ||  (1)  |   5 6 |  (2)  ||  (9)  |  (4)  |  (3)  ||
+||   8   |   8   |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||       |       |     3 ||       |       |       ||
+||  (1)  |  (4)  |   5   ||   5 6 |  (9)  |       ||  (2)  |     6 |     6 ||
+||       |       |       ||       |       |   8   ||       | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |   2   |       ||   2   |       |       ||       |       |   2   ||
+||  (4)  |     6 |  (1)  ||     6 |  (3)  |  (7)  ||  (5)  |  (9)  |     6 ||
+||       |   8   |       ||       |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |   2   |     3 ||       |       |       ||       |       |   2   ||
+||   5 6 |     6 |   5   ||  (9)  |     6 |  (1)  ||  (4)  |     6 |     6 ||
+||   8   | 7 8   |       ||       |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||   2   |       |       ||       |       |       ||
+||     6 |     6 |  (9)  ||   5 6 |   5 6 |  (4)  ||  (3)  |     6 |  (1)  ||
+||   8   | 7 8   |       ||       |   8   |       ||       | 7 8   |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (3)  |  (6)  ||  (8)  |  (2)  |  (5)  ||  (7)  |  (1)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (1)  |  (8)  ||  (4)  |  (7)  |  (9)  ||  (6)  |  (3)  |  (5)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (5)  |  (4)  ||  (3)  |  (1)  |  (6)  ||  (8)  |  (2)  |  (9)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType5(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestUniqueRectangleType6SingleConjugatePair(t *testing.T) {
+	// {6, 9} in r1c4, r1c8, r3c4, r3c8 with diagonal floor cells, but 6 forms a
+	// conjugate pair only in one of the rows.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |       ||       |       |       ||
+||  (8)  |  (1)  |  (2)  ||     6 |       |  (5)  ||  (7)  |     6 |  (4)  ||
+||       |       |       ||     9 |     9 |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||     6 |     6 |     6 ||  (1)  |  (2)  |  (4)  ||  (5)  |  (8)  |  (3)  ||
+||     9 | 7   9 | 7   9 ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       || 1 2   | 1     |   2   ||
+||  (4)  |  (3)  |  (5)  ||     6 |  (8)  |  (7)  ||     6 |     6 |       ||
+||       |       |       ||     9 |       |       ||     9 |     9 |     9 ||
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1     ||       | 1   3 |       ||       |     3 |   2   ||
+||   5   |   5   |       ||  (7)  |   5   |  (6)  ||  (4)  |   5   |   5   ||
+||     9 |     9 |   8 9 ||       |       |       ||       |     9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     ||       |       | 1     ||       |     3 |       ||
+||   5   |   5   |       ||  (2)  |  (4)  |       ||       |   5   |  (6)  ||
+||     9 | 7   9 | 7 8 9 ||       |       |   8   ||   8 9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |   2   |       ||     3 |     3 |       ||   2   |     3 |       ||
+||   5 6 |   5 6 |  (4)  ||       |   5   |  (9)  ||       |   5   |  (1)  ||
+||       | 7     |       ||   8   |       |       ||   8   | 7     |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       |       |       || 1     |       |       ||
+||  (2)  |  (8)  |       ||  (5)  |  (6)  |  (3)  ||       |  (4)  |  (7)  ||
+||       |       |     9 ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       | 1     ||       |       |       ||
+||   5 6 |   5 6 |     6 ||  (4)  |  (7)  |       ||  (3)  |  (2)  |       ||
+||     9 |     9 |     9 ||       |       |   8   ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1     |       || 1     | 1     |       ||
+||  (7)  |  (4)  |  (3)  ||       |       |  (2)  ||     6 |   5 6 |   5   ||
+||       |       |       ||   8 9 |     9 |       ||   8   |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := UniqueRectangleType6(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestHiddenUniqueRectangleSingleConjugatePair(t *testing.T) {
+	// {5, 8} in r1c8, r1c9, r6c8, r6c9 with the floor cell r1c9, but 5 forms a
+	// conjugate pair only in column 8, not in row 6.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |   5   |   5   ||
+||       |   8 9 |       ||       |       |       ||   8 9 |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  || 4     | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  || 4     | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7 8   | 7 8   | 7 8   ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |   5   ||
+|| 7   9 | 7   9 |       ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4 5   |  (9)  |  (7)  || 4     | 4 5   |   5   ||
+||       |       |       ||   8   |       |       ||   8   |   8   |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7 8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := HiddenUniqueRectangle(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
@@ -0,0 +1,98 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+func applyWing(grid *sudoku.Grid, technique string, chain string, cells []*sudoku.Cell, houses []*sudoku.Set, digit int) *Step {
+	step := newStep(technique)
+	step.Description = fmt.Sprintf("%s; either end holds %d", chain, digit)
+	step.Houses = append(step.Houses, houses...)
+	step.Cells = append(step.Cells, cells...)
+
+	eliminateFromCellsSeeingAll(step, grid, []*sudoku.Cell{cells[0], cells[len(cells)-1]}, digit)
+
+	if !step.hasChanges() {
+		return nil
+	}
+
+	return step
+}
+
+// WWing is two bivalue cells of the same digits x and y, each seeing one end
+// of a conjugate pair of y. One of them holds y, so the other holds x.
+func WWing(grid *sudoku.Grid) (*Step, error) {
+	sets := grid.GetSets()
+	bivalue_cells := getBivalueCells(grid)
+
+	for _, combination := range getCombinations(len(bivalue_cells), 2) {
+		cell := bivalue_cells[combination[0]]
+		other_cell := bivalue_cells[combination[1]]
+		if getPencilMarkMask(cell) != getPencilMarkMask(other_cell) || cellsSeeEachOther(cell, other_cell) {
+			continue
+		}
+
+		for _, y := range cell.GetPencilMarks() {
+			x := getOtherPencilMark(cell, y)
+
+			for _, pair := range getHouseConjugatePairs(sets[:], y) {
+				for i := 0; i < 2; i++ {
+					start := pair.cells[i]
+					end := pair.cells[1-i]
+					if !cellsSeeEachOther(cell, start) || !cellsSeeEachOther(other_cell, end) {
+						continue
+					}
+
+					chain := fmt.Sprintf("(%d=%d)%s-(%d)%s=(%d)%s-(%d=%d)%s", x, y, cell, y, start, y, end, y, x, other_cell)
+					if step := applyWing(grid, "W-Wing", chain, []*sudoku.Cell{cell, start, end, other_cell}, []*sudoku.Set{pair.house}, x); step != nil {
+						return step, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// MWing is a bivalue cell of x and y seeing one end of a conjugate pair of y,
+// whose other end is also one end of a conjugate pair of x. Either the
+// bivalue cell holds x, or the first pair puts y and the second pair x in
+// their far ends.
+func MWing(grid *sudoku.Grid) (*Step, error) {
+	sets := grid.GetSets()
+
+	for _, cell := range getBivalueCells(grid) {
+		for _, y := range cell.GetPencilMarks() {
+			x := getOtherPencilMark(cell, y)
+
+			for _, y_pair := range getHouseConjugatePairs(sets[:], y) {
+				for i := 0; i < 2; i++ {
+					start := y_pair.cells[i]
+					middle := y_pair.cells[1-i]
+					if !cellsSeeEachOther(cell, start) || middle == cell {
+						continue
+					}
+
+					for _, x_pair := range getHouseConjugatePairs(sets[:], x) {
+						for j := 0; j < 2; j++ {
+							end := x_pair.cells[1-j]
+							if x_pair.cells[j] != middle || end == cell || end == start {
+								continue
+							}
+
+							chain := fmt.Sprintf("(%d=%d)%s-(%d)%s=(%d-%d)%s=(%d)%s", x, y, cell, y, start, y, x, middle, x, end)
+							if step := applyWing(grid, "M-Wing", chain, []*sudoku.Cell{cell, start, middle, end}, []*sudoku.Set{y_pair.house, x_pair.house}, x); step != nil {
+								return step, nil
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
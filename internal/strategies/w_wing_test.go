@@ -0,0 +1,480 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestWWing(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       | 1   3 || 1     |       |       ||     3 |       |       ||
+||  (7)  |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (5)  |  (2)  ||  (3)  |  (4)  |  (6)  ||  (8)  |  (1)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1     |       ||       | 1     |       ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |  (8)  ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+||     3 |   2   |     3 ||       | 1   3 |       ||   2   |   2   | 1     ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||     6 |   5 6 |       ||
+||   8   |     9 |   8   ||       |       |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 ||       |       | 1 2 3 ||       |   2   | 1     ||
+|| 4     |     6 |     6 ||  (5)  |  (8)  |       ||  (7)  | 4   6 |       ||
+||       |     9 |       ||       |       |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   | 1     |       ||       |       |       ||
+|| 4 5   |     6 |  (7)  ||     6 |     6 |  (9)  || 4 5   |  (3)  |  (8)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |       | 1   3 ||       |       |     3 ||
+||  (2)  |  (4)  |  (5)  ||     6 |  (7)  |       ||  (9)  |  (8)  |     6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||   2   |     3 |   2 3 ||       |       |     3 ||
+||       |  (7)  |       ||     6 |     6 |       || 4 5   | 4 5   |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (6)  |  (3)  |  (9)  ||  (8)  |  (5)  |  (4)  ||  (1)  |  (7)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       | 1   3 || 1     |       |       ||     3 |       |       ||
+||  (7)  |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (5)  |  (2)  ||  (3)  |  (4)  |  (6)  ||  (8)  |  (1)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1     |       ||       | 1     |       ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |  (8)  ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+||     3 |   2   |     3 ||       | 1   3 |       ||   2   |   2   | 1     ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||     6 |   5 6 |       ||
+||   8   |     9 |   8   ||       |       |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 ||       |       | 1 2 3 ||       |   2   | 1     ||
+|| 4     |     6 |     6 ||  (5)  |  (8)  |       ||  (7)  | 4   6 |       ||
+||       |     9 |       ||       |       |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   | 1     |       ||       |       |       ||
+|| 4 5   |     6 |  (7)  ||       |     6 |  (9)  || 4 5   |  (3)  |  (8)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |       | 1   3 ||       |       |     3 ||
+||  (2)  |  (4)  |  (5)  ||     6 |  (7)  |       ||  (9)  |  (8)  |     6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||   2   |     3 |   2 3 ||       |       |     3 ||
+||       |  (7)  |       ||     6 |       |       || 4 5   | 4 5   |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (6)  |  (3)  |  (9)  ||  (8)  |  (5)  |  (4)  ||  (1)  |  (7)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := WWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "W-Wing: (6=1)r6c5-(1)r5c6=(1)r7c6-(1=6)r7c4; either end holds 6 => r6c4<>6, r8c5<>6")
+}
+
+func TestMWing(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |       |  (5)  ||
+||       |   8 9 |       ||       |       |       ||     9 |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  ||       | 4     |     6 ||
+|| 7   9 | 7   9 | 7     ||       |       |       || 7   9 | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  ||       | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7     | 7 8   |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |  (7)  ||
+||     9 |     9 |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4     |  (9)  |  (7)  || 4     |  (5)  |       ||
+||       |       |       ||   8   |       |       ||   8   |       |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7     |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       || 1     | 1     |       ||
+||  (3)  |       |  (2)  ||  (6)  |  (7)  |  (4)  ||       |       |  (5)  ||
+||       |   8 9 |       ||       |       |       ||     9 |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       ||     3 |       |     3 ||
+||     6 | 4     | 4     ||  (2)  |  (5)  |  (8)  ||       | 4     |     6 ||
+||     9 | 7   9 | 7     ||       |       |       || 7   9 | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||   2   |       |   2   ||
+||     6 | 4     |  (5)  ||  (9)  |  (3)  |  (1)  ||       | 4     |     6 ||
+|| 7 8   | 7 8   |       ||       |       |       || 7     | 7 8   |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   | 4 5   |  (8)  || 4 5   |  (1)  |  (3)  ||  (6)  |  (2)  |  (7)  ||
+||     9 |     9 |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||   5   |  (3)  | 4     || 4 5   |  (6)  |  (2)  || 4     |  (9)  |  (1)  ||
+|| 7     |       | 7     ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |       |     3 ||
+||  (2)  |  (1)  |  (6)  || 4     |  (9)  |  (7)  || 4     |  (5)  |       ||
+||       |       |       ||   8   |       |       ||   8   |       |   8   ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |   2   |       ||       | 1     |   2   ||
+||  (4)  |  (6)  |  (3)  ||       |       |  (9)  ||  (5)  |       |       ||
+||       |       |       || 7     |   8   |       ||       | 7     |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       || 1     |   2   |       || 1 2   |       |       ||
+||   5   |   5   |  (9)  ||       |       |  (6)  ||       |  (3)  |  (4)  ||
+|| 7 8   | 7 8   |       || 7     |   8   |       || 7 8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       |       || 1     |       |       ||
+||       |  (2)  |       ||  (3)  |  (4)  |  (5)  ||       |  (6)  |  (9)  ||
+|| 7 8   |       | 7     ||       |       |       || 7 8   |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := MWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "M-Wing: (7=4)r2c8-(4)r2c3=(4-7)r5c3=(7)r5c1; either end holds 7 => r2c1<>7")
+}
+
+func TestWWingAfterOtherChain(t *testing.T) {
+	// A search keeping only the first chain reaching each candidate misses
+	// this wing, as another chain reaches r8c9(3) first.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (1)  |  (3)  |  (4)  ||       |  (7)  |  (9)  ||  (5)  |  (6)  |       ||
+||       |       |       ||   8   |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2 3 |       |       ||   2 3 |       |     3 ||
+||  (5)  |  (7)  |  (8)  ||       |  (1)  |  (6)  ||       |  (4)  |       ||
+||       |       |       ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |     3 ||       |     3 |       ||
+||  (6)  |  (2)  |  (9)  ||  (4)  |  (5)  |       ||  (1)  |       |  (7)  ||
+||       |       |       ||       |       |   8   ||       |   8   |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |  (1)  |  (5)  ||  (6)  |  (9)  |  (2)  ||       |       |  (4)  ||
+||       |       |       ||       |       |       || 7 8   | 7 8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |     3 |       ||
+||  (2)  |  (4)  |  (6)  ||  (7)  |  (8)  |  (5)  ||       |       |  (1)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |       |  (7)  ||  (1)  |  (3)  |  (4)  ||  (6)  |  (2)  |  (5)  ||
+||   8 9 |   8 9 |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |   2   |     3 ||   2 3 |     3 |       ||
+|| 4     |   5   |  (1)  ||   5   | 4     |       ||       |       |  (6)  ||
+||     9 |     9 |       ||   8   |       |   8   || 7   9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||     3 |       |       ||     3 |       |     3 ||
+|| 4     |   5 6 |  (2)  ||   5   | 4   6 |  (7)  ||       |  (1)  |       ||
+||   8 9 |   8 9 |       ||       |       |       ||   8 9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       ||       |       |   2   ||
+||  (7)  |     6 |  (3)  ||  (9)  |     6 |  (1)  ||  (4)  |  (5)  |       ||
+||       |   8   |       ||       |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (1)  |  (3)  |  (4)  ||       |  (7)  |  (9)  ||  (5)  |  (6)  |       ||
+||       |       |       ||   8   |       |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2 3 |       |       ||   2 3 |       |     3 ||
+||  (5)  |  (7)  |  (8)  ||       |  (1)  |  (6)  ||       |  (4)  |       ||
+||       |       |       ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |     3 ||       |     3 |       ||
+||  (6)  |  (2)  |  (9)  ||  (4)  |  (5)  |       ||  (1)  |       |  (7)  ||
+||       |       |       ||       |       |   8   ||       |   8   |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |  (1)  |  (5)  ||  (6)  |  (9)  |  (2)  ||       |       |  (4)  ||
+||       |       |       ||       |       |       || 7 8   | 7 8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |     3 |       ||
+||  (2)  |  (4)  |  (6)  ||  (7)  |  (8)  |  (5)  ||       |       |  (1)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       |       |  (7)  ||  (1)  |  (3)  |  (4)  ||  (6)  |  (2)  |  (5)  ||
+||   8 9 |   8 9 |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |   2   |     3 ||   2   |     3 |       ||
+|| 4     |   5   |  (1)  ||   5   | 4     |       ||       |       |  (6)  ||
+||     9 |     9 |       ||   8   |       |   8   || 7   9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||     3 |       |       ||       |       |     3 ||
+|| 4     |   5 6 |  (2)  ||   5   | 4   6 |  (7)  ||       |  (1)  |       ||
+||   8 9 |   8 9 |       ||       |       |       ||   8 9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |   2   |       ||       |       |   2   ||
+||  (7)  |     6 |  (3)  ||  (9)  |     6 |  (1)  ||  (4)  |  (5)  |       ||
+||       |   8   |       ||       |       |       ||       |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := WWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "W-Wing: (3=9)r5c7-(9)r2c7=(9)r2c9-(9=3)r8c9; either end holds 3 => r7c7<>3, r8c7<>3")
+}
+
+func TestMWingAfterOtherChain(t *testing.T) {
+	// A search keeping only the first chain reaching each candidate misses
+	// this wing, as another chain reaches r6c1(1) first.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (6)  |  (2)  |  (5)  ||  (9)  |  (7)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (6)  ||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||   5 6 |   5 6 |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+|| 1     |       |   2   || 1 2   |       |   2   || 1     | 1 2   |       ||
+||       |  (3)  |       ||       |  (6)  | 4     ||       | 4     |  (5)  ||
+||     9 |       |   8   ||   8   |       | 7     || 7 8   |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1 2   |     3 |       || 1   3 | 1 2 3 |       ||
+||  (5)  |  (7)  |       ||       | 4     |  (9)  ||       | 4     |  (6)  ||
+||       |       |   8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |     3 |   2   || 1   3 | 1 2 3 |       ||
+||       |  (6)  |  (4)  ||  (5)  |       |       ||       |       |       ||
+||     9 |       |       ||       | 7     | 7 8   || 7 8   |     9 | 7   9 ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       ||       | 1     |       ||
+||  (6)  |       |       ||  (3)  |  (5)  |       ||  (2)  |       |  (4)  ||
+||       |   8   | 7   9 ||       |       | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   |       |   2   || 1     | 1     |       ||
+||  (3)  |       |       ||       | 4     | 4   6 ||   5 6 |   5 6 |  (8)  ||
+||       |       | 7   9 ||     9 | 7     | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||   2   |       |       ||     3 |     3 |       ||
+||  (4)  |       |  (5)  ||       |  (1)  |     6 ||     6 |     6 |       ||
+||       |   8   |       ||   8 9 |       |   8   || 7     |     9 | 7   9 ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (6)  |  (2)  |  (5)  ||  (9)  |  (7)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (6)  ||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||   5 6 |   5 6 |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+|| 1     |       |   2   || 1 2   |       |   2   || 1     | 1 2   |       ||
+||       |  (3)  |       ||       |  (6)  | 4     ||       | 4     |  (5)  ||
+||     9 |       |   8   ||   8   |       | 7     || 7 8   |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1 2   |     3 |       || 1   3 | 1 2 3 |       ||
+||  (5)  |  (7)  |       ||       | 4     |  (9)  ||       | 4     |  (6)  ||
+||       |       |   8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |     3 |   2   || 1   3 |   2 3 |       ||
+||       |  (6)  |  (4)  ||  (5)  |       |       ||       |       |       ||
+||     9 |       |       ||       | 7     | 7 8   || 7 8   |     9 | 7   9 ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       ||       | 1     |       ||
+||  (6)  |       |       ||  (3)  |  (5)  |       ||  (2)  |       |  (4)  ||
+||       |   8   | 7   9 ||       |       | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   |       |   2   || 1     | 1     |       ||
+||  (3)  |       |       ||       | 4     | 4   6 ||   5 6 |   5 6 |  (8)  ||
+||       |       | 7   9 ||     9 | 7     | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||   2   |       |       ||     3 |     3 |       ||
+||  (4)  |       |  (5)  ||       |  (1)  |     6 ||     6 |     6 |       ||
+||       |   8   |       ||   8 9 |       |   8   || 7     |     9 | 7   9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := MWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "M-Wing: (1=9)r7c8-(9)r4c8=(9-1)r4c1=(1)r6c1; either end holds 1 => r6c8<>1")
+}
+
+func TestWWingNothingToEliminate(t *testing.T) {
+	// (9=2)r3c5-(2)r3c9=(2)r1c9-(2=9)r1c2 puts 9 into r3c5 or r1c2, but no cell
+	// seeing both holds 9.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |   2   |       ||       |       |       ||       |       |   2   ||
+||  (3)  |       |  (5)  ||  (1)  |  (4)  |  (6)  ||  (7)  |       |       ||
+||       |     9 |       ||       |       |       ||       |   8 9 |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   | 1     ||       |   2   |       || 1     |       |       ||
+||  (4)  |       |       ||  (3)  |       |       ||     6 |     6 |  (5)  ||
+||       |     9 |   8   ||       | 7     | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |   2   |       || 1   3 |       |   2 3 ||
+||  (7)  |  (6)  |       ||  (5)  |       |       ||       |  (4)  |       ||
+||       |       |   8   ||       |     9 |   8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  | 4     ||  (9)  |  (8)  |  (1)  || 4   6 |  (5)  |     6 ||
+||       |       | 7     ||       |       |       ||       |       | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||     3 |     3 |     3 ||
+||  (6)  |  (1)  |  (9)  ||  (2)  |   5   | 4 5   || 4     |       |       ||
+||       |       |       ||       | 7     | 7     ||       |   8   | 7 8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (8)  | 4     ||  (6)  |  (3)  | 4     ||  (2)  |  (1)  |  (9)  ||
+||       |       | 7     ||       |       | 7     ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |     3 |     3 ||
+||  (1)  |  (7)  |  (2)  ||  (4)  |   5   |   5   ||  (8)  |     6 |     6 ||
+||       |       |       ||       |     9 |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (5)  |  (6)  ||  (7)  |  (1)  |  (3)  ||  (9)  |  (2)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (4)  |  (3)  ||  (8)  |  (6)  |  (2)  ||  (5)  |  (7)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := WWing(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestMWingNothingToEliminate(t *testing.T) {
+	// (2=8)r9c4-(8)r2c4=(8-2)r2c7=(2)r2c3 puts 2 into r9c4 or r2c3, but r2c4
+	// holds no 2 and r9c3 is solved.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       | 1   3 || 1   3 |       |       ||     3 |       |       ||
+||       |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+|| 7     |       | 7     ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2 3 ||     3 |       |       ||   2 3 |       |       ||
+||  (9)  |  (5)  |       ||       |  (4)  |  (6)  ||       |  (1)  |  (7)  ||
+||       |       |       ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   |       ||       | 1     | 1   3 ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |       ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |   8   ||   8   |   8 9 |       ||
+##=======================##=======================##=======================##
+|| 1   3 | 1 2   | 1 2 3 ||       | 1   3 |       ||   2   |   2   | 1 2   ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||   5 6 |   5 6 |     6 ||
+||   8   |     9 |   8   ||       |       |       ||   8   |   8   |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1 2   | 1 2 3 ||       |       | 1 2 3 ||   2   |   2   | 1     ||
+|| 4     | 4   6 |     6 ||  (5)  |  (8)  |       || 4   6 | 4   6 |     6 ||
+|| 7     |     9 | 7     ||       |       |       || 7     |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1 2   | 1 2   || 1 2   | 1     |       ||   2   |       | 1 2   ||
+|| 4 5   | 4   6 |     6 ||     6 |     6 |  (9)  || 4 5 6 |  (3)  |     6 ||
+|| 7 8   |       | 7 8   ||       |       |       || 7 8   |       |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       || 1   3 | 1   3 | 1   3 ||       |       |     3 ||
+||  (2)  | 4     |  (5)  ||     6 |     6 |       ||  (9)  | 4   6 |     6 ||
+||       |       |       ||   8   | 7     |   8   ||       | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     || 1 2 3 | 1   3 | 1 2 3 ||   2   |   2   |   2 3 ||
+|| 4     |  (7)  |       ||     6 |   5 6 |       || 4 5 6 | 4 5 6 |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |       |       ||       |       |   2   ||
+||  (6)  |  (3)  |  (9)  ||       |   5   |  (4)  ||  (1)  |   5   |       ||
+||       |       |       ||   8   | 7     |       ||       | 7     |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := MWing(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
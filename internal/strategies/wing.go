@@ -0,0 +1,100 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+func formatCellWithPencilMarks(cell *sudoku.Cell) string {
+	return fmt.Sprintf("%s %s", cell, formatDigits(cell.GetPencilMarks()))
+}
+
+func eliminateFromCellsSeeingAll(step *Step, grid *sudoku.Grid, pattern_cells []*sudoku.Cell, digit int) {
+	for _, cell := range grid.GetAllCells() {
+		if cellsContain(pattern_cells, cell) {
+			continue
+		}
+
+		if cellSeesAll(cell, pattern_cells) {
+			step.eliminate(cell, digit)
+		}
+	}
+}
+
+func getEmptyPeersWithPencilMarkCount(grid *sudoku.Grid, cell *sudoku.Cell, pencil_mark_count int) []*sudoku.Cell {
+	peers := []*sudoku.Cell{}
+
+	for _, peer := range getPeers(grid, cell) {
+		if peer.GetValue() == sudoku.Empty && len(peer.GetPencilMarks()) == pencil_mark_count {
+			peers = append(peers, peer)
+		}
+	}
+
+	return peers
+}
+
+// findWing looks for a pivot with pivot_size candidates and two bivalue
+// pincers it sees, each sharing one pivot digit plus a common digit z.
+// For an XY-Wing z is not in the pivot, for an XYZ-Wing it is.
+func findWing(grid *sudoku.Grid, technique string, pivot_size int) (*Step, error) {
+	for _, pivot := range grid.GetAllCells() {
+		if pivot.GetValue() != sudoku.Empty || len(pivot.GetPencilMarks()) != pivot_size {
+			continue
+		}
+
+		pivot_mask := getPencilMarkMask(pivot)
+		pincer_candidates := getEmptyPeersWithPencilMarkCount(grid, pivot, 2)
+
+		for _, combination := range getCombinations(len(pincer_candidates), 2) {
+			pincer_1 := pincer_candidates[combination[0]]
+			pincer_2 := pincer_candidates[combination[1]]
+			pincer_1_mask := getPencilMarkMask(pincer_1)
+			pincer_2_mask := getPencilMarkMask(pincer_2)
+
+			if pincer_1_mask == pincer_2_mask {
+				continue
+			}
+
+			z_mask := pincer_1_mask & pincer_2_mask
+			if countDigitsInMask(z_mask) != 1 {
+				continue
+			}
+
+			if pivot_size == 2 {
+				if z_mask&pivot_mask != 0 || (pincer_1_mask|pincer_2_mask)&^z_mask != pivot_mask {
+					continue
+				}
+			} else if (pincer_1_mask | pincer_2_mask) != pivot_mask {
+				continue
+			}
+
+			z := getDigitsFromMask(z_mask)[0]
+
+			pattern_cells := []*sudoku.Cell{pincer_1, pincer_2}
+			if pivot_size == 3 {
+				pattern_cells = append(pattern_cells, pivot)
+			}
+
+			step := newStep(technique)
+			step.Description = fmt.Sprintf("pivot %s with pincers %s and %s", formatCellWithPencilMarks(pivot), formatCellWithPencilMarks(pincer_1), formatCellWithPencilMarks(pincer_2))
+			step.Cells = append(step.Cells, pivot, pincer_1, pincer_2)
+
+			eliminateFromCellsSeeingAll(step, grid, pattern_cells, z)
+
+			if step.hasChanges() {
+				return step, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func XYWing(grid *sudoku.Grid) (*Step, error) {
+	return findWing(grid, "XY-Wing", 2)
+}
+
+func XYZWing(grid *sudoku.Grid) (*Step, error) {
+	return findWing(grid, "XYZ-Wing", 3)
+}
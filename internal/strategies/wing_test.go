@@ -0,0 +1,294 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestXYWing(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (1)  |  (8)  || 4     | 4   6 |  (5)  ||  (2)  |  (3)  |     6 ||
+||       |       |       ||     9 |     9 |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (4)  |  (9)  |  (6)  ||  (2)  |  (3)  |  (1)  ||  (8)  |  (5)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (2)  |  (3)  ||  (7)  |     6 |       || 4   6 | 4     |  (1)  ||
+||       |       |       ||       |   8 9 |   8 9 ||       |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||     6 | 4   6 |  (7)  ||  (3)  | 4     |  (2)  ||  (5)  |  (1)  |  (8)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (1)  ||  (8)  |  (5)  |  (6)  ||  (9)  |  (7)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       | 4     |  (5)  || 4     |  (1)  |  (7)  ||  (3)  |  (6)  |  (2)  ||
+||   8 9 |   8   |       ||     9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (5)  | 4     ||  (6)  |  (2)  | 4     ||  (7)  |  (8)  |  (3)  ||
+||       |       |     9 ||       |       |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||     6 |  (7)  | 4     ||  (1)  |       |  (3)  || 4   6 |  (2)  |  (5)  ||
+||   8   |       |     9 ||       |   8 9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |     6 |  (2)  ||  (5)  |  (7)  | 4     ||  (1)  | 4     |     6 ||
+||       |   8   |       ||       |       |   8   ||       |     9 |     9 ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (1)  |  (8)  || 4     | 4   6 |  (5)  ||  (2)  |  (3)  |     6 ||
+||       |       |       ||     9 |     9 |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (4)  |  (9)  |  (6)  ||  (2)  |  (3)  |  (1)  ||  (8)  |  (5)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (2)  |  (3)  ||  (7)  |     6 |       || 4   6 | 4     |  (1)  ||
+||       |       |       ||       |   8 9 |   8 9 ||       |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||     6 | 4   6 |  (7)  ||  (3)  | 4     |  (2)  ||  (5)  |  (1)  |  (8)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (3)  |  (1)  ||  (8)  |  (5)  |  (6)  ||  (9)  |  (7)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||       | 4     |  (5)  || 4     |  (1)  |  (7)  ||  (3)  |  (6)  |  (2)  ||
+||   8 9 |   8   |       ||     9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (5)  | 4     ||  (6)  |  (2)  | 4     ||  (7)  |  (8)  |  (3)  ||
+||       |       |     9 ||       |       |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||     6 |  (7)  | 4     ||  (1)  |       |  (3)  || 4   6 |  (2)  |  (5)  ||
+||   8   |       |     9 ||       |   8 9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |     6 |  (2)  ||  (5)  |  (7)  | 4     ||  (1)  |       |     6 ||
+||       |   8   |       ||       |       |   8   ||       |     9 |     9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XYWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "XY-Wing: pivot r3c6 {8, 9} with pincers r3c8 {4, 9} and r9c6 {4, 8} => r9c8<>4")
+}
+
+func TestXYZWing(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (6)  |  (2)  |  (5)  ||  (9)  |  (7)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (6)  ||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||   5 6 |   5 6 |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+|| 1     |       |   2   || 1 2   |       |   2   || 1     | 1 2   |       ||
+||       |  (3)  |       ||       |  (6)  | 4     ||       | 4     |  (5)  ||
+||     9 |       |   8   ||   8   |       | 7 8   || 7 8   |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1 2   |     3 |       || 1   3 | 1 2 3 |       ||
+||  (5)  |  (7)  |       ||       | 4     |  (9)  ||       | 4     |  (6)  ||
+||       |       |   8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |     3 |   2   || 1   3 | 1 2 3 |       ||
+||       |  (6)  |  (4)  ||  (5)  |       |       ||       |       |       ||
+||     9 |       |       ||       | 7     | 7 8   || 7 8   |     9 | 7   9 ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       ||       | 1     |       ||
+||  (6)  |       |       ||  (3)  |  (5)  |       ||  (2)  |       |  (4)  ||
+||       |   8   | 7   9 ||       |       | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   |       |   2   || 1     | 1     |       ||
+||  (3)  |       |       ||       | 4     | 4   6 ||   5 6 |   5 6 |  (8)  ||
+||       |       | 7   9 ||     9 | 7     | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||   2   |       |   2   ||     3 |     3 |       ||
+||  (4)  |       |  (5)  ||       |  (1)  |     6 ||     6 |     6 |       ||
+||       |   8   |       ||   8 9 |       |   8   || 7     |     9 | 7   9 ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (8)  |  (4)  |  (1)  ||  (6)  |  (2)  |  (5)  ||  (9)  |  (7)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (5)  |  (6)  ||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (9)  |  (3)  ||  (4)  |  (8)  |  (1)  ||   5 6 |   5 6 |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+|| 1     |       |   2   || 1 2   |       |   2   || 1     | 1 2   |       ||
+||       |  (3)  |       ||       |  (6)  | 4     ||       | 4     |  (5)  ||
+||     9 |       |   8   ||   8   |       | 7 8   || 7 8   |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1 2   |     3 |       || 1   3 | 1 2 3 |       ||
+||  (5)  |  (7)  |       ||       | 4     |  (9)  ||       | 4     |  (6)  ||
+||       |       |   8   ||   8   |       |       ||   8   |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |     3 |   2   || 1   3 | 1 2 3 |       ||
+||       |  (6)  |  (4)  ||  (5)  |       |       ||       |       |       ||
+||     9 |       |       ||       | 7     | 7 8   || 7 8   |     9 | 7   9 ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       ||       | 1     |       ||
+||  (6)  |       |       ||  (3)  |  (5)  |       ||  (2)  |       |  (4)  ||
+||       |   8   | 7   9 ||       |       | 7 8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   |       |   2   || 1     | 1     |       ||
+||  (3)  |       |       ||       | 4     | 4   6 ||   5 6 |   5 6 |  (8)  ||
+||       |       | 7   9 ||     9 | 7     | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |   2   |       ||   2   |       |       ||     3 |     3 |       ||
+||  (4)  |       |  (5)  ||       |  (1)  |     6 ||     6 |     6 |       ||
+||       |   8   |       ||   8 9 |       |   8   || 7     |     9 | 7   9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XYZWing(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "XYZ-Wing: pivot r9c4 {2, 8, 9} with pincers r9c2 {2, 8} and r8c4 {2, 9} => r9c6<>2")
+}
+
+func TestXYWingNothingToEliminate(t *testing.T) {
+	// Pivot r1c4 {1, 9} with pincers r1c8 {6, 9} and r7c4 {1, 6}, but r7c8, the
+	// only other cell seeing both pincers, is solved.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       | 1   3 || 1     |       |       ||     3 |       |       ||
+||  (7)  |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (5)  |  (2)  ||  (3)  |  (4)  |  (6)  ||  (8)  |  (1)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1     |       ||       | 1     |       ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |  (8)  ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+||     3 |   2   |     3 ||       | 1   3 |       ||   2   |   2   | 1     ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||     6 |   5 6 |       ||
+||   8   |     9 |   8   ||       |       |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 ||       |       | 1 2 3 ||       |   2   | 1     ||
+|| 4     |     6 |     6 ||  (5)  |  (8)  |       ||  (7)  | 4   6 |       ||
+||       |     9 |       ||       |       |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   | 1     |       ||       |       |       ||
+|| 4 5   |     6 |  (7)  ||     6 |     6 |  (9)  || 4 5   |  (3)  |  (8)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |       | 1   3 ||       |       |     3 ||
+||  (2)  |  (4)  |  (5)  ||     6 |  (7)  |       ||  (9)  |  (8)  |     6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||   2   |     3 |   2 3 ||       |       |     3 ||
+||       |  (7)  |       ||     6 |     6 |       || 4 5   | 4 5   |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (6)  |  (3)  |  (9)  ||  (8)  |  (5)  |  (4)  ||  (1)  |  (7)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XYWing(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestXYZWingNothingToEliminate(t *testing.T) {
+	// Pivot r1c3 {1, 3, 6} with pincers r1c7 {3, 6} and r3c1 {1, 3}, but r1c1
+	// and r1c2, the only other cells seeing all three, are solved.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       | 1   3 || 1     |       |       ||     3 |       |       ||
+||  (7)  |  (8)  |     6 ||       |  (2)  |  (5)  ||     6 |     6 |  (4)  ||
+||       |       |       ||     9 |       |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (5)  |  (2)  ||  (3)  |  (4)  |  (6)  ||  (8)  |  (1)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 | 1     |       ||       | 1     |       ||   2 3 |   2   |       ||
+||       |     6 |  (4)  ||  (7)  |       |  (8)  ||     6 |     6 |  (5)  ||
+||       |       |       ||       |     9 |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+||     3 |   2   |     3 ||       | 1   3 |       ||   2   |   2   | 1     ||
+||   5   |     6 |     6 ||  (4)  |     6 |  (7)  ||     6 |   5 6 |       ||
+||   8   |     9 |   8   ||       |       |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |   2   | 1   3 ||       |       | 1 2 3 ||       |   2   | 1     ||
+|| 4     |     6 |     6 ||  (5)  |  (8)  |       ||  (7)  | 4   6 |       ||
+||       |     9 |       ||       |       |       ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||   2   | 1     |       ||       |       |       ||
+|| 4 5   |     6 |  (7)  ||     6 |     6 |  (9)  || 4 5   |  (3)  |  (8)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       || 1     |       | 1   3 ||       |       |     3 ||
+||  (2)  |  (4)  |  (5)  ||     6 |  (7)  |       ||  (9)  |  (8)  |     6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||   2   |     3 |   2 3 ||       |       |     3 ||
+||       |  (7)  |       ||     6 |     6 |       || 4 5   | 4 5   |     6 ||
+||   8   |       |   8   ||     9 |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (6)  |  (3)  |  (9)  ||  (8)  |  (5)  |  (4)  ||  (1)  |  (7)  |  (2)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XYZWing(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
@@ -0,0 +1,110 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+const max_x_cycle_length = 10
+
+func getXCycleGraph(sets [27]*sudoku.Set, digit int, grouped bool) *chainGraph {
+	graph := newChainGraph()
+	graph.addHouseLinks(sets, digit, grouped)
+
+	return graph
+}
+
+func newXCycleStep(technique string, loop *chainPath, reason string) *Step {
+	step := loop.newStep(technique)
+	step.Description = fmt.Sprintf("loop on %d %s; %s", loop.nodes[0].digit, loop.format(false), reason)
+
+	return step
+}
+
+// applyXCycle applies the nice loop rules. In a continuous loop every weak
+// link becomes strong, so the digit is removed from cells seeing both of its
+// nodes. A discontinuous loop meeting itself with two strong links places the
+// digit, with two weak links it eliminates it.
+func applyXCycle(grid *sudoku.Grid, technique string, loop *chainPath) *Step {
+	first_strong := loop.strong[0]
+	last_strong := loop.strong[len(loop.strong)-1]
+	start := loop.nodes[0]
+	digit := start.digit
+
+	switch {
+	case first_strong && !last_strong:
+		step := newXCycleStep(technique, loop, "continuous, every weak link is strong")
+		loop_cells := loop.getCells()
+		all_cells := grid.GetAllCells()
+
+		for i, link := range loop.links {
+			if loop.strong[i] {
+				continue
+			}
+
+			link_cells := append(append([]*sudoku.Cell{}, loop.nodes[i].cells...), link.node.cells...)
+			for _, cell := range getCellsWithPencilMark(all_cells[:], digit) {
+				if !cellsContain(loop_cells, cell) && cellSeesAll(cell, link_cells) {
+					step.eliminate(cell, digit)
+				}
+			}
+		}
+
+		if step.hasChanges() {
+			return step
+		}
+
+	case first_strong && last_strong:
+		if len(start.cells) != 1 {
+			return nil
+		}
+
+		step := newXCycleStep(technique, loop, fmt.Sprintf("discontinuous, %s must hold %d", start, digit))
+		step.place(start.cells[0], digit)
+		return step
+
+	case !first_strong && !last_strong:
+		step := newXCycleStep(technique, loop, fmt.Sprintf("discontinuous, %s cannot hold %d", start, digit))
+		for _, cell := range start.cells {
+			step.eliminate(cell, digit)
+		}
+		return step
+	}
+
+	return nil
+}
+
+func findXCycle(grid *sudoku.Grid, technique string, grouped bool) (*Step, error) {
+	sets := grid.GetSets()
+
+	graphs := []*chainGraph{}
+	for digit := 1; digit <= 9; digit++ {
+		graphs = append(graphs, getXCycleGraph(sets, digit, grouped))
+	}
+
+	for length := 3; length <= max_x_cycle_length; length++ {
+		for _, graph := range graphs {
+			var step *Step
+
+			graph.findLoops(length, func(loop *chainPath) bool {
+				step = applyXCycle(grid, technique, loop)
+				return step != nil
+			})
+
+			if step != nil {
+				return step, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func XCycle(grid *sudoku.Grid) (*Step, error) {
+	return findXCycle(grid, "X-Cycle", false)
+}
+
+func GroupedXCycle(grid *sudoku.Grid) (*Step, error) {
+	return findXCycle(grid, "Grouped X-Cycle", true)
+}
@@ -0,0 +1,658 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestXCycleContinuous(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       |       ||       | 1     |       ||     3 |       |       ||
+||       |  (9)  |  (2)  ||  (8)  |     6 |  (7)  ||     6 |  (5)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 ||       |       |       ||       | 1     | 1   3 ||
+||  (6)  |  (8)  |       ||  (2)  |  (4)  |  (5)  ||  (7)  |       |       ||
+||       |       |       ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       |       ||       | 1     |       ||
+||  (7)  |  (4)  |  (5)  ||     6 |  (3)  |     6 ||     6 |     6 |  (2)  ||
+||       |       |       ||     9 |       |     9 ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+|| 1     |       | 1     ||       |       |       ||       |       |       ||
+||       |  (5)  |     6 ||  (4)  |     6 |  (3)  ||     6 |  (2)  |  (7)  ||
+||   8 9 |       |   8 9 ||       |   8 9 |       ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (4)  |  (2)  |     6 ||     6 |     6 |  (1)  ||  (5)  |  (3)  |     6 ||
+||       |       |   8 9 || 7   9 | 7 8 9 |       ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       |       |       ||       |       |       ||
+||       |  (7)  |     6 ||  (5)  |     6 |  (2)  ||  (1)  |  (4)  |     6 ||
+||   8 9 |       |   8 9 ||       |   8 9 |       ||       |       |   8 9 ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |       |       ||       |       |     3 ||
+||  (5)  |  (1)  |       ||     6 |  (2)  |     6 ||  (4)  |     6 |     6 ||
+||       |       |   8 9 || 7   9 |       |   8 9 ||       | 7 8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       |       ||
+||       |  (3)  |  (4)  ||     6 |     6 |     6 ||  (2)  |     6 |  (5)  ||
+||   8 9 |       |       || 7   9 | 7   9 |   8 9 ||       | 7 8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||     3 |       |       ||     3 | 1     | 1   3 ||
+||  (2)  |  (6)  |  (7)  ||       |  (5)  |  (4)  ||       |       |       ||
+||       |       |       ||     9 |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1   3 |       |       ||       | 1     |       ||     3 |       |       ||
+||       |  (9)  |  (2)  ||  (8)  |     6 |  (7)  ||     6 |  (5)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1   3 ||       |       |       ||       | 1     | 1   3 ||
+||  (6)  |  (8)  |       ||  (2)  |  (4)  |  (5)  ||  (7)  |       |       ||
+||       |       |       ||       |       |       ||       |     9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       |       ||       | 1     |       ||
+||  (7)  |  (4)  |  (5)  ||     6 |  (3)  |     6 ||     6 |     6 |  (2)  ||
+||       |       |       ||     9 |       |     9 ||   8   |   8   |       ||
+##=======================##=======================##=======================##
+|| 1     |       | 1     ||       |       |       ||       |       |       ||
+||       |  (5)  |     6 ||  (4)  |     6 |  (3)  ||     6 |  (2)  |  (7)  ||
+||   8 9 |       |   8 9 ||       |   8 9 |       ||   8 9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (4)  |  (2)  |     6 ||     6 |     6 |  (1)  ||  (5)  |  (3)  |     6 ||
+||       |       |   8 9 || 7   9 | 7 8 9 |       ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       |       |       ||       |       |       ||
+||       |  (7)  |     6 ||  (5)  |     6 |  (2)  ||  (1)  |  (4)  |     6 ||
+||   8 9 |       |   8 9 ||       |   8 9 |       ||       |       |   8 9 ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |       |       ||       |       |     3 ||
+||  (5)  |  (1)  |       ||     6 |  (2)  |     6 ||  (4)  |     6 |     6 ||
+||       |       |   8 9 || 7   9 |       |   8 9 ||       | 7   9 |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     | 1     |       ||       |       |       ||
+||       |  (3)  |  (4)  ||     6 |     6 |     6 ||  (2)  |     6 |  (5)  ||
+||   8 9 |       |       || 7   9 | 7   9 |   8 9 ||       | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||     3 |       |       ||     3 | 1     | 1   3 ||
+||  (2)  |  (6)  |  (7)  ||       |  (5)  |  (4)  ||       |       |       ||
+||       |       |       ||     9 |       |       ||   8 9 |   8 9 |   8 9 ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XCycle(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "X-Cycle: loop on 8 r7c3=r8c1-r8c6=r7c6-r7c3; continuous, every weak link is strong => r8c8<>8, r7c8<>8, r7c9<>8")
+}
+
+func TestXCycleDiscontinuousStrong(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (9)  |  (7)  ||  (3)  |  (2)  |  (1)  ||  (6)  |  (8)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |  (4)  |  (6)  ||  (5)  |  (8)  |  (7)  ||  (9)  |  (2)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (8)  |  (2)  ||  (9)  |  (4)  |  (6)  ||  (5)  |  (3)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |     3 |       ||     3 |       |       ||
+|| 4     |  (5)  |  (1)  ||  (6)  |       |  (8)  || 4     |  (9)  |  (2)  ||
+|| 7     |       |       ||       | 7     |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       |       || 1     |       |       ||
+|| 4     |  (3)  |  (9)  ||       |  (5)  |  (2)  || 4     |  (6)  |  (8)  ||
+|| 7     |       |       || 7     |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1   3 |       || 1   3 | 1     |       ||
+||  (6)  |  (2)  |  (8)  ||  (4)  |       |  (9)  ||       |       |  (5)  ||
+||       |       |       ||       |       |       || 7     | 7     |       ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       || 1     |       |       ||
+||  (2)  |       |  (3)  ||  (8)  |  (6)  |  (5)  ||       |  (4)  |  (9)  ||
+||       | 7     |       ||       |       |       || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       |       ||       | 1     |       ||
+||  (8)  |  (6)  |  (5)  ||       |  (9)  |  (4)  ||  (2)  |       |  (3)  ||
+||       |       |       || 7     |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       | 1     |       ||       |       |       ||
+||  (9)  |       |  (4)  ||  (2)  |       |  (3)  ||  (8)  |  (5)  |  (6)  ||
+||       | 7     |       ||       | 7     |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (5)  |  (9)  |  (7)  ||  (3)  |  (2)  |  (1)  ||  (6)  |  (8)  |  (4)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |  (4)  |  (6)  ||  (5)  |  (8)  |  (7)  ||  (9)  |  (2)  |  (1)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (8)  |  (2)  ||  (9)  |  (4)  |  (6)  ||  (5)  |  (3)  |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |     3 |       ||     3 |       |       ||
+|| 4     |  (5)  |  (1)  ||  (6)  |       |  (8)  || 4     |  (9)  |  (2)  ||
+|| 7     |       |       ||       | 7     |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       || 1     |       |       ||
+|| 4     |  (3)  |  (9)  ||  (1)  |  (5)  |  (2)  || 4     |  (6)  |  (8)  ||
+|| 7     |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1   3 |       || 1   3 | 1     |       ||
+||  (6)  |  (2)  |  (8)  ||  (4)  |       |  (9)  ||       |       |  (5)  ||
+||       |       |       ||       |       |       || 7     | 7     |       ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       |       |       || 1     |       |       ||
+||  (2)  |       |  (3)  ||  (8)  |  (6)  |  (5)  ||       |  (4)  |  (9)  ||
+||       | 7     |       ||       |       |       || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |       |       ||       | 1     |       ||
+||  (8)  |  (6)  |  (5)  ||       |  (9)  |  (4)  ||  (2)  |       |  (3)  ||
+||       |       |       || 7     |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       | 1     |       ||       |       |       ||
+||  (9)  |       |  (4)  ||  (2)  |       |  (3)  ||  (8)  |  (5)  |  (6)  ||
+||       | 7     |       ||       | 7     |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XCycle(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "X-Cycle: loop on 1 r5c4=r5c7-r7c7=r8c8-r8c4=r5c4; discontinuous, r5c4 must hold 1 => r5c4=1")
+}
+
+func TestXCycleDiscontinuousWeak(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |   2   |   2   ||       |   2   |       ||       |       |       ||
+||  (6)  |   5   |   5   ||  (7)  |       |  (4)  ||  (3)  |  (1)  |  (9)  ||
+||       |   8   |       ||       |   8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   |       |       ||       |       | 1 2   ||       |   2   |       ||
+||       |  (9)  |  (7)  ||  (3)  |  (6)  |       ||  (4)  |       |  (5)  ||
+||   8   |       |       ||       |       |   8   ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||       |       | 1 2   ||       |       |   2   ||
+||  (3)  |       |  (4)  ||   5   |  (9)  |   5   ||  (6)  |  (7)  |       ||
+||       |   8   |       ||   8   |       |   8   ||       |       |   8   ||
+##=======================##=======================##=======================##
+||   2   |   2 3 | 1 2 3 ||       | 1 2 3 |   2 3 ||       |       |       ||
+|| 4 5   |   5   |   5   || 4 5   |       |   5   ||  (7)  |  (9)  |  (6)  ||
+||   8   |   8   |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |   2 3 | 1 2 3 ||       |       |       ||       |   2 3 | 1 2 3 ||
+|| 4 5   |   5   |   5   ||  (6)  |  (7)  |  (9)  ||  (8)  | 4 5   | 4     ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2 3 |   2 3 || 1     |   2 3 | 1 2 3 ||
+||  (7)  |  (6)  |  (9)  || 4 5   |       |   5   ||   5   | 4 5   | 4     ||
+||       |       |       ||   8   |   8   |   8   ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |     3 ||       |     3 |     3 ||
+||  (9)  |  (7)  |  (6)  ||  (1)  |  (5)  |       ||  (2)  | 4     | 4     ||
+||       |       |       ||       |       |   8   ||       |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |   2 3 ||       |     3 |       || 1     |       | 1   3 ||
+||   5   |  (4)  |   5   ||  (9)  |       |  (7)  ||   5   |  (6)  |       ||
+||       |       |       ||       |   8   |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1   3 |       ||       |       |       ||       |     3 |       ||
+||   5   |   5   |  (8)  ||  (2)  |  (4)  |  (6)  ||  (9)  |   5   |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |   2   |   2   ||       |   2   |       ||       |       |       ||
+||  (6)  |   5   |   5   ||  (7)  |       |  (4)  ||  (3)  |  (1)  |  (9)  ||
+||       |   8   |       ||       |   8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   |       |       ||       |       | 1 2   ||       |   2   |       ||
+||       |  (9)  |  (7)  ||  (3)  |  (6)  |       ||  (4)  |       |  (5)  ||
+||       |       |       ||       |       |   8   ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   |       ||       |       | 1 2   ||       |       |   2   ||
+||  (3)  |       |  (4)  ||   5   |  (9)  |   5   ||  (6)  |  (7)  |       ||
+||       |   8   |       ||   8   |       |   8   ||       |       |   8   ||
+##=======================##=======================##=======================##
+||   2   |   2 3 | 1 2 3 ||       | 1 2 3 |   2 3 ||       |       |       ||
+|| 4 5   |   5   |   5   || 4 5   |       |   5   ||  (7)  |  (9)  |  (6)  ||
+||   8   |   8   |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |   2 3 | 1 2 3 ||       |       |       ||       |   2 3 | 1 2 3 ||
+|| 4 5   |   5   |   5   ||  (6)  |  (7)  |  (9)  ||  (8)  | 4 5   | 4     ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1 2 3 |   2 3 || 1     |   2 3 | 1 2 3 ||
+||  (7)  |  (6)  |  (9)  || 4 5   |       |   5   ||   5   | 4 5   | 4     ||
+||       |       |       ||   8   |   8   |   8   ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |     3 ||       |     3 |     3 ||
+||  (9)  |  (7)  |  (6)  ||  (1)  |  (5)  |       ||  (2)  | 4     | 4     ||
+||       |       |       ||       |       |   8   ||       |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |   2 3 ||       |     3 |       || 1     |       | 1   3 ||
+||   5   |  (4)  |   5   ||  (9)  |       |  (7)  ||   5   |  (6)  |       ||
+||       |       |       ||       |   8   |       ||       |       |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1   3 |       ||       |       |       ||       |     3 |       ||
+||   5   |   5   |  (8)  ||  (2)  |  (4)  |  (6)  ||  (9)  |   5   |  (7)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XCycle(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "X-Cycle: loop on 8 r2c1-r2c8=r7c8-r7c6=r8c5-r1c5=r1c2-r2c1; discontinuous, r2c1 cannot hold 8 => r2c1<>8")
+}
+
+func TestXCycleNothingToEliminate(t *testing.T) {
+	// The loop on 2 r3c7=r3c9-r4c9=r4c2-r6c2=r6c7-r3c7 is continuous, but no
+	// cell seeing both ends of one of its weak links holds 2.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |       ||       |       |       ||
+||  (8)  |  (1)  |  (2)  ||     6 |       |  (5)  ||  (7)  |     6 |  (4)  ||
+||       |       |       ||     9 |     9 |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||     6 |     6 |     6 ||  (1)  |  (2)  |  (4)  ||  (5)  |  (8)  |  (3)  ||
+||     9 | 7   9 | 7   9 ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       || 1 2   | 1     |   2   ||
+||  (4)  |  (3)  |  (5)  ||     6 |  (8)  |  (7)  ||     6 |     6 |       ||
+||       |       |       ||     9 |       |       ||     9 |     9 |     9 ||
+##=======================##=======================##=======================##
+|| 1   3 |   2   | 1     ||       | 1   3 |       ||       |     3 |   2   ||
+||   5   |   5   |       ||  (7)  |   5   |  (6)  ||  (4)  |   5   |   5   ||
+||     9 |     9 |   8 9 ||       |       |       ||       |     9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1   3 |       | 1     ||       |       | 1     ||       |     3 |       ||
+||   5   |   5   |       ||  (2)  |  (4)  |       ||       |   5   |  (6)  ||
+||     9 | 7   9 | 7 8 9 ||       |       |   8   ||   8 9 | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |   2   |       ||     3 |     3 |       ||   2   |     3 |       ||
+||   5 6 |   5 6 |  (4)  ||       |   5   |  (9)  ||       |   5   |  (1)  ||
+||       | 7     |       ||   8   |       |       ||   8   | 7     |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       |       |       || 1     |       |       ||
+||  (2)  |  (8)  |       ||  (5)  |  (6)  |  (3)  ||       |  (4)  |  (7)  ||
+||       |       |     9 ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       | 1     ||       |       | 1     ||       |       |       ||
+||   5 6 |   5 6 |     6 ||  (4)  |  (7)  |       ||  (3)  |  (2)  |       ||
+||     9 |     9 |     9 ||       |       |   8   ||       |       |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       | 1     |       || 1     | 1     |       ||
+||  (7)  |  (4)  |  (3)  ||       |       |  (2)  ||     6 |   5 6 |   5   ||
+||       |       |       ||   8 9 |     9 |       ||   8   |       |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XCycle(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestGroupedXCycleContinuous(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |     3 |       ||       |     3 |       ||
+||  (2)  |  (5)  |  (4)  ||  (9)  |       |  (1)  ||  (8)  |     6 |     6 ||
+||       |       |       ||       | 7     |       ||       | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |     3 ||   2 3 |       |   2   ||       |     3 |       ||
+||       |       |       || 4     |  (6)  |       ||  (5)  | 4     |  (1)  ||
+|| 7 8 9 | 7   9 | 7     ||       |       |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 | 1     | 1   3 ||       |       |     3 ||       |       |       ||
+||     6 |     6 |       ||  (5)  | 4     |       ||  (2)  | 4     |  (9)  ||
+||   8   |       |       ||       | 7     |   8   ||       | 7     |       ||
+##=======================##=======================##=======================##
+||       |       |       ||   2   |       |       ||       |   2   |       ||
+||  (1)  |       |  (8)  ||       |  (5)  |  (4)  ||  (3)  |     6 |     6 ||
+||       | 7   9 |       || 7     |       |       ||       | 7   9 | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |       |     3 ||       |       |       ||
+||       |  (4)  |  (2)  ||  (6)  |  (8)  |       ||  (1)  |       |  (5)  ||
+|| 7   9 |       |       ||       |       |     9 ||       | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       || 1 2 3 | 1 2   |   2   ||       |   2   |       ||
+||  (5)  |       |  (6)  ||       |       |       ||  (4)  |       |  (8)  ||
+||       | 7     |       || 7     |       |     9 ||       | 7   9 |       ||
+##=======================##=======================##=======================##
+||       |       |       || 1 2   | 1 2   |       ||       |       |       ||
+||  (4)  |  (8)  |  (9)  ||       |       |  (7)  ||  (6)  |  (5)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |     3 |       ||       |       |       ||
+||       |  (2)  |  (5)  ||  (8)  |       |  (6)  ||       |  (1)  |  (4)  ||
+|| 7     |       |       ||       |     9 |       || 7   9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 | 1     | 1   3 ||     3 |     3 |       ||       |       |       ||
+||     6 |     6 |       || 4     | 4     |  (5)  ||       |  (8)  |  (2)  ||
+|| 7     |       | 7     ||       |     9 |       || 7   9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |       |       ||       |     3 |       ||       |     3 |       ||
+||  (2)  |  (5)  |  (4)  ||  (9)  |       |  (1)  ||  (8)  |     6 |     6 ||
+||       |       |       ||       | 7     |       ||       | 7     | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||   2 3 |       |   2   ||       |     3 |       ||
+||       |       |       || 4     |  (6)  |       ||  (5)  | 4     |  (1)  ||
+|| 7 8 9 | 7   9 | 7     ||       |       |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 | 1     | 1   3 ||       |       |     3 ||       |       |       ||
+||     6 |     6 |       ||  (5)  | 4     |       ||  (2)  | 4     |  (9)  ||
+||   8   |       |       ||       | 7     |   8   ||       | 7     |       ||
+##=======================##=======================##=======================##
+||       |       |       ||   2   |       |       ||       |   2   |       ||
+||  (1)  |       |  (8)  ||       |  (5)  |  (4)  ||  (3)  |     6 |     6 ||
+||       | 7   9 |       || 7     |       |       ||       | 7   9 | 7     ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |       |     3 ||       |       |       ||
+||       |  (4)  |  (2)  ||  (6)  |  (8)  |       ||  (1)  |       |  (5)  ||
+|| 7   9 |       |       ||       |       |     9 ||       | 7   9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       || 1 2 3 | 1 2   |   2   ||       |   2   |       ||
+||  (5)  |       |  (6)  ||       |       |       ||  (4)  |       |  (8)  ||
+||       | 7     |       || 7     |       |     9 ||       | 7   9 |       ||
+##=======================##=======================##=======================##
+||       |       |       || 1 2   | 1 2   |       ||       |       |       ||
+||  (4)  |  (8)  |  (9)  ||       |       |  (7)  ||  (6)  |  (5)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |     3 |       ||       |       |       ||
+||       |  (2)  |  (5)  ||  (8)  |       |  (6)  ||       |  (1)  |  (4)  ||
+|| 7     |       |       ||       |     9 |       || 7   9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 | 1     | 1   3 ||     3 |     3 |       ||       |       |       ||
+||     6 |     6 |       || 4     | 4     |  (5)  ||       |  (8)  |  (2)  ||
+|| 7     |       | 7     ||       |     9 |       || 7   9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := GroupedXCycle(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Grouped X-Cycle: loop on 3 r2c2=r6c2-r6c4=r5c6-r3c6=r3c13-r2c2; continuous, every weak link is strong => r2c3<>3")
+}
+
+func TestGroupedXCycleDiscontinuousWeak(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |   2   |       ||       |   2   |       ||       |       |       ||
+|| 4 5   |   5   |  (3)  ||  (1)  |       |  (7)  ||  (6)  | 4     |  (9)  ||
+||       |       |       ||       |   8   |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   | 1     ||   2   |       |       ||       |       |       ||
+|| 4   6 |     6 |     6 ||       |     6 |  (5)  ||  (3)  | 4     |  (7)  ||
+||     9 |     9 |     9 ||   8   |     9 |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |     6 |  (8)  ||     6 |  (4)  |  (3)  ||  (2)  |  (1)  |  (5)  ||
+||       |     9 |       ||     9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (3)  |  (4)  ||     6 |  (5)  |  (9)  ||  (8)  |     6 |  (2)  ||
+||       |       |       || 7     |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||     3 | 1   3 | 1     ||       |     3 |       ||
+||  (2)  |  (7)  |     6 ||       |     6 |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |     9 ||   8   |   8   |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |       ||       |     3 |     3 ||
+||     6 |  (8)  |  (5)  ||  (4)  |     6 |  (2)  ||  (1)  |     6 |     6 ||
+||     9 |       |       ||       | 7     |       ||       | 7   9 |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1 2 3 | 1     ||       |   2 3 | 1   3 ||
+||  (8)  |  (4)  |     6 ||  (5)  |     6 |     6 ||       |       |     6 ||
+||       |       | 7   9 ||       | 7   9 |       || 7   9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     | 1     ||   2 3 | 1 2 3 |       ||       |   2 3 | 1   3 ||
+||   5 6 |   5 6 |     6 ||       |     6 |  (8)  ||  (4)  |     6 |     6 ||
+||     9 |     9 | 7   9 ||       | 7   9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       | 1     |       ||       |       |       ||
+||  (3)  |     6 |  (2)  ||     6 |     6 |  (4)  ||       |  (5)  |  (8)  ||
+||       |     9 |       || 7   9 | 7   9 |       || 7   9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||       |   2   |       ||       |   2   |       ||       |       |       ||
+|| 4 5   |   5   |  (3)  ||  (1)  |       |  (7)  ||  (6)  | 4     |  (9)  ||
+||       |       |       ||       |   8   |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   | 1     ||   2   |       |       ||       |       |       ||
+|| 4   6 |     6 |     6 ||       |     6 |  (5)  ||  (3)  | 4     |  (7)  ||
+||     9 |     9 |     9 ||   8   |     9 |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |     6 |  (8)  ||     6 |  (4)  |  (3)  ||  (2)  |  (1)  |  (5)  ||
+||       |     9 |       ||     9 |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (1)  |  (3)  |  (4)  ||       |  (5)  |  (9)  ||  (8)  |     6 |  (2)  ||
+||       |       |       || 7     |       |       ||       | 7     |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||     3 | 1   3 | 1     ||       |     3 |       ||
+||  (2)  |  (7)  |     6 ||       |     6 |     6 ||  (5)  |     6 |  (4)  ||
+||       |       |     9 ||   8   |   8   |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |       ||       |     3 |     3 ||
+||     6 |  (8)  |  (5)  ||  (4)  |     6 |  (2)  ||  (1)  |     6 |     6 ||
+||     9 |       |       ||       | 7     |       ||       | 7   9 |       ||
+##=======================##=======================##=======================##
+||       |       | 1     ||       | 1 2 3 | 1     ||       |   2 3 | 1   3 ||
+||  (8)  |  (4)  |     6 ||  (5)  |     6 |     6 ||       |       |     6 ||
+||       |       | 7   9 ||       | 7   9 |       || 7   9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     | 1     ||   2 3 | 1 2 3 |       ||       |   2 3 | 1   3 ||
+||   5 6 |   5 6 |     6 ||       |     6 |  (8)  ||  (4)  |     6 |     6 ||
+||     9 |     9 | 7   9 ||       | 7   9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||       | 1     |       ||       |       |       ||
+||  (3)  |     6 |  (2)  ||     6 |     6 |  (4)  ||       |  (5)  |  (8)  ||
+||       |     9 |       || 7   9 | 7   9 |       || 7   9 |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := GroupedXCycle(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Grouped X-Cycle: loop on 6 r4c4-r3c4=r3c2-r9c2=r9c45-r7c6=r5c6-r4c4; discontinuous, r4c4 cannot hold 6 => r4c4<>6")
+}
+
+func TestGroupedXCycleDiscontinuousStrong(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1     |       |       ||   2   |   2   |       ||       |   2   | 1     ||
+||   5   |  (3)  |  (7)  ||   5 6 |   5   |  (9)  ||  (4)  |     6 |       ||
+||       |       |       ||   8   |   8   |       ||       |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1     |       ||   2   |   2   | 1     || 1     |   2   |       ||
+|| 4 5   |     6 |   5 6 || 4 5   |   5   |       ||       |       |  (3)  ||
+||     9 |     9 |       ||   8   | 7 8   | 7 8   || 7     | 7 8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |     3 | 1   3 || 1     |       |       ||
+|| 4     |  (2)  |  (8)  || 4   6 |       |       ||     6 |  (5)  |  (9)  ||
+||       |       |       ||       | 7     | 7     || 7     |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |       |  (4)  ||   5   |   5   |  (6)  ||   5   |  (1)  |  (2)  ||
+||       | 7 8   |       ||   8 9 | 7 8 9 |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   ||       |       |   2   ||       |       |       ||
+||   5   |     6 |   5 6 ||  (3)  |  (1)  |       ||  (8)  | 4   6 | 4 5   ||
+|| 7   9 |     9 |       ||       |       | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1     |   2   ||       |       |   2   ||     3 |     3 |       ||
+||   5   |     6 |   5 6 ||   5   |  (4)  |       ||   5 6 |     6 |  (7)  ||
+||   8 9 |     9 |       ||     9 |       |   8   ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||   2   |   2   |     3 ||     3 |     3 |       ||
+||  (6)  | 4 5   |  (1)  ||       |       | 4     ||   5   | 4     | 4 5   ||
+||       | 7 8   |       ||     9 |     9 |   8   || 7     | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |       ||       |     3 |       ||
+||       | 4     |  (9)  ||  (1)  |       |  (5)  ||  (2)  | 4     |  (6)  ||
+|| 7 8   | 7 8   |       ||       |   8   |       ||       | 7 8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       || 1     |       | 1     ||
+||  (2)  | 4 5   |  (3)  ||  (7)  |  (6)  | 4     ||   5   | 4     | 4 5   ||
+||       |   8   |       ||       |       |   8   ||     9 |   8 9 |   8   ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+|| 1     |       |       ||   2   |   2   |       ||       |   2   | 1     ||
+||   5   |  (3)  |  (7)  ||   5 6 |   5   |  (9)  ||  (4)  |     6 |       ||
+||       |       |       ||   8   |   8   |       ||       |   8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     | 1     |       ||   2   |   2   | 1     || 1     |   2   |       ||
+|| 4 5   |     6 |   5 6 || 4 5   |   5   |       ||       |       |  (3)  ||
+||     9 |     9 |       ||   8   | 7 8   | 7 8   || 7     | 7 8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |     3 | 1   3 || 1     |       |       ||
+|| 4     |  (2)  |  (8)  || 4   6 |       |       ||     6 |  (5)  |  (9)  ||
+||       |       |       ||       | 7     | 7     || 7     |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (3)  |       |  (4)  ||   5   |   5   |  (6)  ||   5   |  (1)  |  (2)  ||
+||       | 7 8   |       ||   8 9 | 7 8 9 |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   ||       |       |   2   ||       |       |       ||
+||   5   |     6 |   5 6 ||  (3)  |  (1)  |       ||  (8)  | 4   6 | 4 5   ||
+|| 7   9 |     9 |       ||       |       | 7     ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |   2   ||       |       |   2   ||     3 |     3 |       ||
+||  (8)  |     6 |   5 6 ||   5   |  (4)  |       ||   5 6 |     6 |  (7)  ||
+||       |     9 |       ||     9 |       |   8   ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||   2   |   2   |     3 ||     3 |     3 |       ||
+||  (6)  | 4 5   |  (1)  ||       |       | 4     ||   5   | 4     | 4 5   ||
+||       | 7 8   |       ||     9 |     9 |   8   || 7     | 7 8   |   8   ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |       ||       |     3 |       ||
+||       | 4     |  (9)  ||  (1)  |       |  (5)  ||  (2)  | 4     |  (6)  ||
+|| 7 8   | 7 8   |       ||       |   8   |       ||       | 7 8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       || 1     |       | 1     ||
+||  (2)  | 4 5   |  (3)  ||  (7)  |  (6)  | 4     ||   5   | 4     | 4 5   ||
+||       |   8   |       ||       |       |   8   ||     9 |   8 9 |   8   ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := GroupedXCycle(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Grouped X-Cycle: loop on 8 r6c1=r6c6-r79c6=r8c5-r8c1=r6c1; discontinuous, r6c1 must hold 8 => r6c1=8")
+}
+
+func TestGroupedXCycleNothingToEliminate(t *testing.T) {
+	// The loop on 6 r6c6=r4c456-r4c9=r12c9-r3c7=r6c7-r6c6 is continuous, but no
+	// cell seeing both ends of one of its weak links holds 6.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |     3 |       ||   2 3 |       |       ||       |       |     3 ||
+||   5   |   5 6 |  (4)  ||     6 |  (1)  |  (9)  ||  (8)  |  (7)  |   5 6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |     3 |       ||   2 3 |     3 |     3 ||       |       |     3 ||
+||   5   |   5 6 |  (9)  ||     6 |     6 |     6 ||  (4)  |  (1)  |   5 6 ||
+|| 7     | 7     |       ||   8   |   8   |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       |       ||       |     3 |       ||
+||  (8)  |     6 |  (1)  ||  (5)  |  (4)  |  (7)  ||     6 |       |  (2)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |     3 ||       |       |     3 ||
+||  (1)  |   5   |  (2)  ||     6 |     6 | 4   6 ||  (7)  |   5   | 4   6 ||
+||       |     9 |       ||   8   |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |     3 ||
+||   5   | 4 5   |  (6)  ||  (7)  |  (2)  |  (1)  ||   5   |  (8)  | 4     ||
+||       |     9 |       ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |     3 ||   2   |   2 3 |       ||
+||       | 4     |  (8)  ||  (9)  |  (5)  | 4   6 ||     6 |       |  (1)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       |     3 |   2 3 ||   2   |       |       ||
+||     6 |  (8)  |   5   ||  (1)  |     6 |     6 ||   5   |  (4)  |  (7)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |     3 |       ||       |       |       ||
+||  (4)  |  (2)  |       ||       |       |  (5)  ||  (1)  |  (6)  |  (9)  ||
+||       |       | 7     ||   8   | 7 8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |   2   |       ||
+||     6 |  (1)  |   5   ||  (4)  |     6 |     6 ||  (3)  |   5   |  (8)  ||
+||     9 |       | 7     ||       | 7   9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := GroupedXCycle(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
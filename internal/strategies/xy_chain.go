@@ -0,0 +1,153 @@
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+)
+
+const max_xy_chain_length = 10
+
+func isBivalue(cell *sudoku.Cell) bool {
+	return cell.GetValue() == sudoku.Empty && len(cell.GetPencilMarks()) == 2
+}
+
+func getBivalueCells(grid *sudoku.Grid) []*sudoku.Cell {
+	cells := []*sudoku.Cell{}
+	for _, cell := range grid.GetAllCells() {
+		if isBivalue(cell) {
+			cells = append(cells, cell)
+		}
+	}
+
+	return cells
+}
+
+func getOtherPencilMark(cell *sudoku.Cell, digit int) int {
+	pencil_marks := cell.GetPencilMarks()
+	if pencil_marks[0] == digit {
+		return pencil_marks[1]
+	}
+
+	return pencil_marks[0]
+}
+
+// getXYChainGraph links the candidates inside every bivalue cell strongly and
+// the same digits of bivalue cells seeing each other weakly, so every chain of
+// the graph is an XY-Chain.
+func getXYChainGraph(grid *sudoku.Grid, bivalue_cells []*sudoku.Cell) *chainGraph {
+	graph := newChainGraph()
+	graph.addCellLinks(bivalue_cells)
+	graph.addPeerLinks(grid.GetSets(), bivalue_cells)
+
+	return graph
+}
+
+// applyXYChain removes z from the cells seeing both ends of a chain starting
+// and ending with z, as one of its ends holds it.
+func applyXYChain(grid *sudoku.Grid, chain *chainPath) *Step {
+	first := chain.nodes[0]
+	last := chain.nodes[len(chain.nodes)-1]
+	z := first.digit
+	if last.digit != z {
+		return nil
+	}
+
+	step := chain.newStep("XY-Chain")
+	step.Description = fmt.Sprintf("%s; either end holds %d", chain.formatEureka(), z)
+	eliminateFromCellsSeeingAll(step, grid, []*sudoku.Cell{first.cells[0], last.cells[0]}, z)
+
+	if !step.hasChanges() {
+		return nil
+	}
+
+	return step
+}
+
+func XYChain(grid *sudoku.Grid) (*Step, error) {
+	var step *Step
+
+	getXYChainGraph(grid, getBivalueCells(grid)).findChains(5, 2*max_xy_chain_length-1, func(chain *chainPath) bool {
+		step = applyXYChain(grid, chain)
+		return step != nil
+	})
+
+	return step, nil
+}
+
+// getRemotePairClusters 2-colours the bivalue cells holding the same pair
+// that see each other, so neighbouring cells hold opposite digits.
+func getRemotePairClusters(bivalue_cells []*sudoku.Cell) [][2][]*sudoku.Cell {
+	clusters := [][2][]*sudoku.Cell{}
+	coloured := map[*sudoku.Cell]bool{}
+
+	for _, start := range bivalue_cells {
+		if coloured[start] {
+			continue
+		}
+
+		mask := getPencilMarkMask(start)
+		colours := map[*sudoku.Cell]int{start: 0}
+		coloured[start] = true
+		queue := []*sudoku.Cell{start}
+
+		for len(queue) > 0 {
+			cell := queue[0]
+			queue = queue[1:]
+
+			for _, other_cell := range bivalue_cells {
+				if coloured[other_cell] || getPencilMarkMask(other_cell) != mask || !cellsSeeEachOther(cell, other_cell) {
+					continue
+				}
+
+				coloured[other_cell] = true
+				colours[other_cell] = 1 - colours[cell]
+				queue = append(queue, other_cell)
+			}
+		}
+
+		if len(colours) < 4 {
+			continue
+		}
+
+		cluster := [2][]*sudoku.Cell{}
+		for cell, colour := range colours {
+			cluster[colour] = append(cluster[colour], cell)
+		}
+		sortCells(cluster[0])
+		sortCells(cluster[1])
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters
+}
+
+func RemotePairs(grid *sudoku.Grid) (*Step, error) {
+	for _, cluster := range getRemotePairClusters(getBivalueCells(grid)) {
+		digits := cluster[0][0].GetPencilMarks()
+
+		step := newStep("Remote Pairs")
+		step.Description = fmt.Sprintf("%s coloured A: %s, B: %s; cells seeing both colours cannot hold %d or %d", formatDigits(digits), formatCells(cluster[0]), formatCells(cluster[1]), digits[0], digits[1])
+		step.Cells = append(step.Cells, cluster[0]...)
+		step.Cells = append(step.Cells, cluster[1]...)
+
+		for _, cell := range grid.GetAllCells() {
+			if cellsContain(step.Cells, cell) {
+				continue
+			}
+
+			if cellSeesAny(cell, cluster[0]) && cellSeesAny(cell, cluster[1]) {
+				for _, digit := range digits {
+					step.eliminate(cell, digit)
+				}
+			}
+		}
+
+		if step.hasChanges() {
+			return step, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,294 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/alltilla/sudoku-solver/internal/sudoku"
+	. "github.com/alltilla/sudoku-solver/internal/test_utils"
+)
+
+func TestXYChain(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2 3 |     3 |       ||   2 3 |       |       ||       |       |     3 ||
+||   5   |   5 6 |  (4)  ||     6 |  (1)  |  (9)  ||  (8)  |  (7)  |   5 6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2 3 |     3 |       ||   2 3 |     3 |     3 ||       |       |     3 ||
+||   5   |   5 6 |  (9)  ||     6 |     6 |     6 ||  (4)  |  (1)  |   5 6 ||
+|| 7     | 7     |       ||   8   |   8   |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       |       ||       |     3 |       ||
+||  (8)  |     6 |  (1)  ||  (5)  |  (4)  |  (7)  ||     6 |       |  (2)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |     3 ||       |       |     3 ||
+||  (1)  |   5   |  (2)  ||     6 |     6 | 4   6 ||  (7)  |   5   | 4   6 ||
+||       |     9 |       ||   8   |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |     3 ||
+||   5   | 4 5   |  (6)  ||  (7)  |  (2)  |  (1)  ||   5   |  (8)  | 4     ||
+||       |     9 |       ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |     3 ||   2   |   2 3 |       ||
+||       | 4     |  (8)  ||  (9)  |  (5)  | 4   6 ||     6 |       |  (1)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       |     3 |   2 3 ||   2   |       |       ||
+||     6 |  (8)  |   5   ||  (1)  |     6 |     6 ||   5   |  (4)  |  (7)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |     3 |       ||       |       |       ||
+||  (4)  |  (2)  |       ||       |       |  (5)  ||  (1)  |  (6)  |  (9)  ||
+||       |       | 7     ||   8   | 7 8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |   2   |       ||
+||     6 |  (1)  |   5   ||  (4)  |     6 |     6 ||  (3)  |   5   |  (8)  ||
+||     9 |       | 7     ||       | 7   9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||   2   |     3 |       ||   2 3 |       |       ||       |       |     3 ||
+||   5   |   5 6 |  (4)  ||     6 |  (1)  |  (9)  ||  (8)  |  (7)  |   5 6 ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |     3 |       ||   2 3 |     3 |     3 ||       |       |     3 ||
+||   5   |   5 6 |  (9)  ||     6 |     6 |     6 ||  (4)  |  (1)  |   5 6 ||
+|| 7     | 7     |       ||   8   |   8   |   8   ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |     3 |       ||       |       |       ||       |     3 |       ||
+||  (8)  |     6 |  (1)  ||  (5)  |  (4)  |  (7)  ||     6 |       |  (2)  ||
+||       |       |       ||       |       |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |     3 |     3 ||       |       |     3 ||
+||  (1)  |   5   |  (2)  ||     6 |     6 | 4   6 ||  (7)  |   5   | 4   6 ||
+||       |     9 |       ||   8   |   8   |   8   ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |       |       ||       |       |     3 ||
+||   5   | 4 5   |  (6)  ||  (7)  |  (2)  |  (1)  ||   5   |  (8)  | 4     ||
+||       |     9 |       ||       |       |       ||     9 |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |       ||       |       |     3 ||   2   |   2 3 |       ||
+||       | 4     |  (8)  ||  (9)  |  (5)  | 4   6 ||     6 |       |  (1)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |     3 ||       |     3 |   2 3 ||   2   |       |       ||
+||     6 |  (8)  |   5   ||  (1)  |     6 |     6 ||   5   |  (4)  |  (7)  ||
+||     9 |       |       ||       |     9 |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |     3 |       ||       |       |       ||
+||  (4)  |  (2)  |       ||       |       |  (5)  ||  (1)  |  (6)  |  (9)  ||
+||       |       | 7     ||   8   | 7 8   |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |   2   ||       |   2   |       ||
+||     6 |  (1)  |   5   ||  (4)  |     6 |     6 ||  (3)  |   5   |  (8)  ||
+||     9 |       | 7     ||       | 7   9 |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XYChain(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "XY-Chain: (3=6)r3c2-(6=9)r3c7-(9=5)r5c7-(5=3)r5c1; either end holds 3 => r1c1<>3, r2c1<>3, r5c2<>3, r6c2<>3")
+}
+
+func TestXYChainNothingToEliminate(t *testing.T) {
+	// Either end of (4=9)r3c7-(9=2)r3c5-(2=9)r1c6-(9=4)r8c6 holds 4, but no
+	// cell seeing both r3c7 and r8c6 holds 4.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+|| 1 2   | 1     | 1 2   ||       |       |   2   ||       | 1     | 1     ||
+|| 4 5   | 4     | 4     ||  (6)  |  (3)  |       ||  (7)  | 4 5   | 4     ||
+||     9 |     9 |     9 ||       |       |     9 ||       |   8 9 |   8 9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1     |       |       ||       |       |       ||       |       | 1     ||
+||   5   |  (6)  |  (3)  ||  (4)  |  (8)  |  (7)  ||   5   |  (2)  |       ||
+||     9 |       |       ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |       ||       |   2   |       ||       |       |       ||
+|| 4     |  (8)  |  (7)  ||  (5)  |       |  (1)  || 4     |  (6)  |  (3)  ||
+||     9 |       |       ||       |     9 |       ||     9 |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||       |  (2)  | 4     ||  (1)  | 4     |  (6)  ||  (3)  | 4     |  (5)  ||
+|| 7 8   |       |     9 ||       | 7     |       ||       |   8 9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     | 1     ||       |   2   |       ||   2   |       | 1 2   ||
+||  (6)  | 4     | 4 5   ||  (8)  | 4 5   |  (3)  || 4     |  (7)  | 4     ||
+||       |     9 |     9 ||       |       |       ||     9 |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1     ||       |   2   |   2   ||       | 1     | 1 2   ||
+||       |  (3)  | 4 5   ||  (9)  | 4 5   | 4     ||  (6)  | 4     | 4     ||
+|| 7 8   |       |       ||       | 7     |       ||       |   8   |   8   ||
+##=======================##=======================##=======================##
+||       | 1     |       ||       | 1     |       ||       |       |       ||
+||  (3)  | 4     |  (6)  ||  (2)  | 4     |  (5)  ||  (8)  | 4     |  (7)  ||
+||       |     9 |       ||       |     9 |       ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |       |       ||       |       |       ||       |       |   2   ||
+|| 4     |  (5)  |  (8)  ||  (7)  |  (6)  | 4     ||  (1)  |  (3)  | 4     ||
+||     9 |       |       ||       |       |     9 ||       |       |     9 ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+|| 1 2   |       | 1 2   ||       | 1     |       ||   2   |       |       ||
+|| 4     |  (7)  | 4     ||  (3)  | 4     |  (8)  || 4 5   | 4 5   |  (6)  ||
+||     9 |       |     9 ||       |     9 |       ||     9 |     9 |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := XYChain(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
+
+func TestRemotePairs(t *testing.T) {
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||   2   |   2   |       ||       |       |     3 ||   2 3 |   2   |       ||
+|| 4     | 4 5   | 4     ||  (6)  |  (1)  |   5   ||   5   |       |  (9)  ||
+||       | 7     | 7 8   ||       |       | 7 8   ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||     3 |       |       || 1   3 | 1     |       ||
+||  (6)  |   5   |       || 4     | 4 5   |  (2)  ||   5   |       |  (7)  ||
+||       |       |   8 9 ||   8 9 |       |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   | 1 2   ||       |       |       || 1 2   |       |       ||
+||  (3)  |   5   |       ||       |   5   |   5   ||   5   |  (6)  |  (4)  ||
+||       | 7     | 7 8 9 ||   8 9 | 7     | 7 8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       | 1 2   | 1 2   ||       |       |       ||       |       | 1 2   ||
+||  (9)  | 4     | 4     ||  (5)  |  (3)  |  (6)  ||  (8)  |  (7)  |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1 2   ||       |       |       || 1 2   |       |       ||
+||  (5)  |  (6)  |       ||  (7)  |  (8)  |  (4)  ||       |  (9)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (8)  |  (3)  ||  (2)  |  (9)  |  (1)  ||  (4)  |  (5)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |   2   |     3 ||       |   2 3 |       ||
+||  (1)  |  (9)  | 4 5   || 4     | 4 5 6 |   5   ||     6 |       |  (8)  ||
+||       |       | 7     ||       | 7     | 7     || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |   2   || 1     |   2   |       ||       |       | 1 2   ||
+||  (8)  |  (3)  |   5   ||       |   5 6 |   5   ||     6 |  (4)  |       ||
+||       |       | 7     ||     9 | 7     | 7   9 || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |   2   |       || 1   3 |   2   |     3 ||       | 1   3 |       ||
+|| 4     | 4     |  (6)  ||       | 4     |       ||  (9)  |       |  (5)  ||
+||       | 7     |       ||   8   | 7     |   8   ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	const expected_grid_str = `
+##=======================##=======================##=======================##
+||   2   |   2   |       ||       |       |     3 ||   2 3 |   2   |       ||
+|| 4     | 4 5   | 4     ||  (6)  |  (1)  |   5   ||   5   |       |  (9)  ||
+||       | 7     | 7 8   ||       |       | 7 8   ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1     |       ||     3 |       |       || 1   3 | 1     |       ||
+||  (6)  |   5   |       || 4     | 4 5   |  (2)  ||   5   |       |  (7)  ||
+||       |       |   8 9 ||   8 9 |       |       ||       |   8   |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       | 1 2   | 1 2   ||       |       |       || 1 2   |       |       ||
+||  (3)  |   5   |       ||       |   5   |   5   ||   5   |  (6)  |  (4)  ||
+||       | 7     | 7 8 9 ||   8 9 | 7     | 7 8 9 ||       |       |       ||
+##=======================##=======================##=======================##
+||       | 1 2   | 1 2   ||       |       |       ||       |       | 1 2   ||
+||  (9)  | 4     | 4     ||  (5)  |  (3)  |  (6)  ||  (8)  |  (7)  |       ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       | 1 2   ||       |       |       || 1 2   |       |       ||
+||  (5)  |  (6)  |       ||  (7)  |  (8)  |  (4)  ||       |  (9)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (7)  |  (8)  |  (3)  ||  (2)  |  (9)  |  (1)  ||  (4)  |  (5)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||     3 |   2   |     3 ||       |   2 3 |       ||
+||  (1)  |  (9)  | 4 5   || 4     | 4 5 6 |   5   ||     6 |       |  (8)  ||
+||       |       | 7     ||       | 7     | 7     || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       || 1     |   2   |       ||       |       | 1 2   ||
+||  (8)  |  (3)  |   5   ||       |   5 6 |   5   ||     6 |  (4)  |       ||
+||       |       | 7     ||     9 | 7     | 7   9 || 7     |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||   2   |   2   |       || 1   3 |   2   |     3 ||       | 1   3 |       ||
+|| 4     | 4     |  (6)  ||       | 4     |       ||  (9)  |       |  (5)  ||
+||       | 7     |       ||   8   | 7     |   8   ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := RemotePairs(grid)
+	AssertNoError(t, err)
+	AssertChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, expected_grid_str)
+	AssertStepString(t, step, "Remote Pairs: {1, 2} coloured A: r4c9, r5c3, B: r5c7, r8c9; cells seeing both colours cannot hold 1 or 2 => r8c3<>2")
+}
+
+func TestRemotePairsNothingToEliminate(t *testing.T) {
+	// r1c4 and r9c2 hold one digit of {3, 7} and r1c2 and r9c1 the other, but
+	// no cell seeing a cell of each colour holds 3 or 7.
+	const initial_grid_str = `
+##=======================##=======================##=======================##
+||       |     3 |       ||     3 |       |       ||       |       |       ||
+||  (4)  |       |  (9)  ||       |  (6)  |  (8)  ||  (2)  |  (5)  |  (1)  ||
+||       | 7     |       || 7     |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |     3 |       ||       |     3 |       ||
+||  (1)  |  (8)  |  (2)  ||  (5)  | 4     | 4     ||  (6)  |       |  (7)  ||
+||       |       |       ||       |       |     9 ||       |     9 |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |     3 ||     3 |       |       ||       |     3 |       ||
+||  (5)  |  (6)  |       ||       |  (1)  |  (2)  ||  (4)  |       |  (8)  ||
+||       |       | 7     || 7   9 |       |       ||       |     9 |       ||
+##=======================##=======================##=======================##
+||       |       |       ||       |       |       ||       |       |       ||
+||  (9)  |  (2)  | 4     ||  (8)  | 4     |  (1)  ||  (3)  |  (6)  |  (5)  ||
+||       |       | 7     ||       | 7     |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |       |     3 ||       |       |       ||       |       |       ||
+||     6 |  (1)  | 4     ||     6 |  (5)  | 4     ||  (8)  |  (7)  |  (2)  ||
+||       |       |       ||     9 |       |     9 ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||   2   |   2   |       ||       |       |       ||
+||     6 |  (5)  |  (8)  ||     6 |       |  (3)  ||  (9)  |  (1)  |  (4)  ||
+|| 7     |       |       ||       | 7     |       ||       |       |       ||
+##=======================##=======================##=======================##
+||       |       |       ||   2 3 |   2 3 |       ||       |       |       ||
+||  (8)  |  (9)  |  (5)  ||       |       |  (7)  ||  (1)  |  (4)  |  (6)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||       |       |       ||       |       |       ||       |       |       ||
+||  (2)  |  (4)  |  (6)  ||  (1)  |  (9)  |  (5)  ||  (7)  |  (8)  |  (3)  ||
+||       |       |       ||       |       |       ||       |       |       ||
+||-------+-------+-------||-------+-------+-------||-------+-------+-------||
+||     3 |     3 |       ||       |       |       ||       |       |       ||
+||       |       |  (1)  ||  (4)  |  (8)  |  (6)  ||  (5)  |  (2)  |  (9)  ||
+|| 7     | 7     |       ||       |       |       ||       |       |       ||
+##=======================##=======================##=======================##
+`
+
+	grid := sudoku.NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(initial_grid_str))
+
+	step, err := RemotePairs(grid)
+	AssertNoError(t, err)
+	AssertNoChanged(t, step)
+	AssertGridEqualsWithPrettyString(t, grid, initial_grid_str)
+}
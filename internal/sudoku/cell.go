@@ -188,3 +188,7 @@ func (c *Cell) AddPencilMarks(pencil_marks []int) error {
 func (c *Cell) RemovePencilMarks(pencil_marks []int) error {
 	return c.changePencilMarks(pencil_marks, false)
 }
+
+func (c *Cell) String() string {
+	return fmt.Sprintf("r%dc%d", c.row, c.column)
+}
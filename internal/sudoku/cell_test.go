@@ -358,3 +358,11 @@ func TestRemovePencilMarksInvalid(t *testing.T) {
 		})
 	}
 }
+
+func TestCellString(t *testing.T) {
+	cell, _ := NewCell(3, 7)
+
+	if cell.String() != "r3c7" {
+		t.Errorf("unexpected string: %s", cell.String())
+	}
+}
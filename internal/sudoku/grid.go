@@ -1,6 +1,7 @@
 package sudoku
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -15,6 +16,10 @@ type Set struct {
 	Cells       [9]*Cell
 }
 
+func (s *Set) String() string {
+	return fmt.Sprintf("%s %d", s.Orientation, s.Index)
+}
+
 func NewGrid() *Grid {
 	g := Grid{}
 
@@ -161,6 +166,29 @@ func (g *Grid) Equals(other *Grid) bool {
 	return true
 }
 
+func (g *Grid) Clone() *Grid {
+	clone := Grid{}
+	cells := make([]Cell, 81)
+
+	for i := 0; i < 9; i++ {
+		clone.cells_by_box[i] = make([]*Cell, 0, 9)
+	}
+
+	for row := 0; row < 9; row++ {
+		for column := 0; column < 9; column++ {
+			cell := &cells[row*9+column]
+			*cell = *g.cells[row][column]
+
+			clone.cells[row][column] = cell
+
+			box_id := cell.GetBoxId()
+			clone.cells_by_box[box_id-1] = append(clone.cells_by_box[box_id-1], cell)
+		}
+	}
+
+	return &clone
+}
+
 func (g *Grid) makeFullyEmpty() {
 	for _, cell := range g.GetAllCells() {
 		if err := cell.SetValue(Empty); err != nil {
@@ -188,3 +216,58 @@ func (g *Grid) LoadPrettyString(pretty_string string) error {
 
 	return nil
 }
+
+func (g *Grid) PrettyString() string {
+	return g.serializeGridPrettyString()
+}
+
+func (g *Grid) LoadLineString(line_string string) error {
+	line_string_trimmed := strings.TrimSpace(line_string)
+
+	if err := validateLineString(line_string_trimmed); err != nil {
+		return err
+	}
+
+	g.makeFullyEmpty()
+
+	if err := g.deserializeGridLineString(line_string_trimmed); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *Grid) LineString() string {
+	return g.serializeGridLineString()
+}
+
+func (g *Grid) Validate() error {
+	for _, set := range g.GetSets() {
+		var seen [9]bool
+
+		for _, cell := range set.Cells {
+			value := cell.GetValue()
+			if value == Empty {
+				continue
+			}
+
+			if seen[value-1] {
+				return fmt.Errorf("error in %s %d: digit appears multiple times: %d", set.Orientation, set.Index, value)
+			}
+
+			seen[value-1] = true
+		}
+	}
+
+	return nil
+}
+
+func (g *Grid) IsSolved() bool {
+	for _, cell := range g.GetAllCells() {
+		if cell.GetValue() == Empty {
+			return false
+		}
+	}
+
+	return g.Validate() == nil
+}
@@ -28,8 +28,12 @@ func validateFormat(input string, format string) error {
 	return nil
 }
 
-func validateFrame(pretty_string string) error {
-	frame := strings.TrimSpace(`
+var pretty_string_row_length = len("##=======================##=======================##=======================##\n")
+var pretty_string_row_offsets = [9]int{1, 5, 9, 13, 17, 21, 25, 29, 33}
+var pretty_string_column_offsets = [9]int{3, 11, 19, 28, 36, 44, 53, 61, 69}
+
+func getPrettyStringFrame() string {
+	return strings.TrimSpace(`
 ##=======================##=======================##=======================##
 || ? ? ? | ? ? ? | ? ? ? || ? ? ? | ? ? ? | ? ? ? || ? ? ? | ? ? ? | ? ? ? ||
 || ????? | ????? | ????? || ????? | ????? | ????? || ????? | ????? | ????? ||
@@ -68,18 +72,18 @@ func validateFrame(pretty_string string) error {
 || ? ? ? | ? ? ? | ? ? ? || ? ? ? | ? ? ? | ? ? ? || ? ? ? | ? ? ? | ? ? ? ||
 ##=======================##=======================##=======================##
 `)
+}
 
-	return validateFormat(pretty_string, frame)
+func validateFrame(pretty_string string) error {
+	return validateFormat(pretty_string, getPrettyStringFrame())
 }
 
 func createCellPrettyString(pretty_string string, row_offset int, column_offset int) (string, error) {
-	row_length := len("##=======================##=======================##=======================##\n")
-
 	var cell_pretty_string bytes.Buffer
 
 	for i := 0; i < 3; i++ {
 		for j := 0; j < 5; j++ {
-			char_index := (row_offset+i)*row_length + column_offset + j
+			char_index := (row_offset+i)*pretty_string_row_length + column_offset + j
 			if err := cell_pretty_string.WriteByte(pretty_string[char_index]); err != nil {
 				return "", err
 			}
@@ -177,11 +181,8 @@ func deserializeCellPrettyString(cell_pretty_string string, cell *Cell) error {
 }
 
 func (g *Grid) deserializeGridPrettyString(pretty_string string) error {
-	row_offsets := [9]int{1, 5, 9, 13, 17, 21, 25, 29, 33}
-	column_offsets := [9]int{3, 11, 19, 28, 36, 44, 53, 61, 69}
-
-	for i, row_offset := range row_offsets {
-		for j, column_offset := range column_offsets {
+	for i, row_offset := range pretty_string_row_offsets {
+		for j, column_offset := range pretty_string_column_offsets {
 			row := i + 1
 			column := j + 1
 
@@ -203,3 +204,100 @@ func (g *Grid) deserializeGridPrettyString(pretty_string string) error {
 
 	return nil
 }
+
+func serializeCellPrettyString(cell *Cell) string {
+	if cell.GetValue() != Empty {
+		return fmt.Sprintf("     \n (%d) \n     \n", cell.GetValue())
+	}
+
+	var cell_pretty_string bytes.Buffer
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if j > 0 {
+				cell_pretty_string.WriteByte(' ')
+			}
+
+			digit := i*3 + j + 1
+			if cell.pencil_marks[digit-1] {
+				cell_pretty_string.WriteString(strconv.Itoa(digit))
+			} else {
+				cell_pretty_string.WriteByte(' ')
+			}
+		}
+
+		cell_pretty_string.WriteByte('\n')
+	}
+
+	return cell_pretty_string.String()
+}
+
+func (g *Grid) serializeGridPrettyString() string {
+	pretty_string := []byte(strings.ReplaceAll(getPrettyStringFrame(), "?", " "))
+
+	for i, row_offset := range pretty_string_row_offsets {
+		for j, column_offset := range pretty_string_column_offsets {
+			cell := g.cells[i][j]
+			cell_lines := strings.Split(serializeCellPrettyString(cell), "\n")
+
+			for k := 0; k < 3; k++ {
+				char_index := (row_offset+k)*pretty_string_row_length + column_offset
+				copy(pretty_string[char_index:char_index+5], cell_lines[k])
+			}
+		}
+	}
+
+	return string(pretty_string) + "\n"
+}
+
+func validateLineString(line_string string) error {
+	if len(line_string) != 81 {
+		return fmt.Errorf("line string must be 81 characters long. actual length: %d", len(line_string))
+	}
+
+	for i := 0; i < len(line_string); i++ {
+		char := line_string[i]
+		if char != '.' && (char < '0' || char > '9') {
+			return fmt.Errorf("invalid character at position %d: %c", i+1, char)
+		}
+	}
+
+	return nil
+}
+
+func (g *Grid) deserializeGridLineString(line_string string) error {
+	for i, cell := range g.GetAllCells() {
+		char := line_string[i]
+		if char == '.' || char == '0' {
+			if err := cell.SetValue(Empty); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, err := strconv.Atoi(string(char))
+		if err != nil {
+			return err
+		}
+
+		if err := cell.SetValue(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *Grid) serializeGridLineString() string {
+	var line_string bytes.Buffer
+
+	for _, cell := range g.GetAllCells() {
+		if cell.GetValue() == Empty {
+			line_string.WriteByte('.')
+		} else {
+			line_string.WriteString(strconv.Itoa(cell.GetValue()))
+		}
+	}
+
+	return line_string.String()
+}
@@ -125,3 +125,76 @@ func TestLoadPrettyString(t *testing.T) {
 		t.Errorf("Grids do not match")
 	}
 }
+
+func TestPrettyString(t *testing.T) {
+	grid := NewGrid()
+	AssertNoError(t, grid.LoadPrettyString(TEST_GRID_PRETTY_STRING))
+
+	if grid.PrettyString() != TEST_GRID_PRETTY_STRING[1:] {
+		t.Errorf("unexpected pretty string:\n%s", grid.PrettyString())
+	}
+}
+
+func TestPrettyStringRoundTrip(t *testing.T) {
+	grid := NewGrid()
+	setCell(grid, 1, 1, 5, []int{})
+	setCell(grid, 2, 2, Empty, []int{1, 9})
+	setCell(grid, 3, 3, Empty, []int{4})
+	cell, _ := grid.GetCell(9, 9)
+	cell.RemovePencilMarks([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	loaded_grid := NewGrid()
+	AssertNoError(t, loaded_grid.LoadPrettyString(grid.PrettyString()))
+
+	if !loaded_grid.Equals(grid) {
+		t.Errorf("Grids do not match")
+	}
+}
+
+func TestLoadLineString(t *testing.T) {
+	expected_grid := NewGrid()
+	setCell(expected_grid, 1, 1, 4, []int{})
+	setCell(expected_grid, 1, 7, 8, []int{})
+	setCell(expected_grid, 1, 9, 5, []int{})
+	setCell(expected_grid, 2, 2, 3, []int{})
+	setCell(expected_grid, 9, 9, 9, []int{})
+
+	grid := NewGrid()
+	AssertNoError(t, grid.LoadLineString(`
+4.....8.5.3.....................................................................9
+`))
+
+	if !grid.Equals(expected_grid) {
+		t.Errorf("Grids do not match")
+	}
+
+	AssertNoError(t, grid.LoadLineString("400000805030000000000000000000000000000000000000000000000000000000000000000000009"))
+
+	if !grid.Equals(expected_grid) {
+		t.Errorf("Grids do not match")
+	}
+}
+
+func TestLoadLineStringInvalid(t *testing.T) {
+	grid := NewGrid()
+
+	AssertError(t, grid.LoadLineString("4.....8.5.3"))
+	AssertError(t, grid.LoadLineString("4.....8.5.3.....................................................................x9"))
+}
+
+func TestLineString(t *testing.T) {
+	const line_string = "4.....8.5.3..........7......2.....6.....8.4......1.......6.3.7.5..2.....1.4......"
+
+	grid := NewGrid()
+	AssertNoError(t, grid.LoadLineString(line_string))
+
+	if grid.LineString() != line_string {
+		t.Errorf("unexpected line string: %s", grid.LineString())
+	}
+
+	AssertNoError(t, grid.LoadPrettyString(TEST_GRID_PRETTY_STRING))
+
+	if grid.LineString() != "1........"+".2......."+"..3......"+"...4....."+"....5...."+".....6..."+"......7.."+".......8."+"........9" {
+		t.Errorf("unexpected line string: %s", grid.LineString())
+	}
+}
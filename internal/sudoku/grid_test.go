@@ -339,3 +339,85 @@ func TestGridEquals(t *testing.T) {
 		t.Errorf("the two grids should not be equal")
 	}
 }
+
+func TestValidate(t *testing.T) {
+	grid := NewGrid()
+	grid.LoadPrettyString(TEST_GRID_PRETTY_STRING)
+
+	AssertNoError(t, grid.Validate())
+
+	cell, _ := grid.GetCell(1, 9)
+	cell.SetValue(1)
+
+	AssertError(t, grid.Validate())
+}
+
+func TestIsSolved(t *testing.T) {
+	grid := NewGrid()
+	grid.LoadPrettyString(TEST_GRID_PRETTY_STRING)
+
+	if grid.IsSolved() {
+		t.Errorf("grid should not be solved")
+	}
+
+	solution := [9][9]int{
+		{4, 8, 3, 9, 2, 1, 6, 5, 7},
+		{9, 6, 7, 3, 4, 5, 8, 2, 1},
+		{2, 5, 1, 8, 7, 6, 4, 9, 3},
+		{5, 4, 8, 1, 3, 2, 9, 7, 6},
+		{7, 2, 9, 5, 6, 4, 1, 3, 8},
+		{1, 3, 6, 7, 9, 8, 2, 4, 5},
+		{3, 7, 2, 6, 8, 9, 5, 1, 4},
+		{8, 1, 4, 2, 5, 3, 7, 6, 9},
+		{6, 9, 5, 4, 1, 7, 3, 8, 2},
+	}
+
+	for row := 1; row <= 9; row++ {
+		for column := 1; column <= 9; column++ {
+			cell, _ := grid.GetCell(row, column)
+			cell.SetValue(solution[row-1][column-1])
+		}
+	}
+
+	if !grid.IsSolved() {
+		t.Errorf("grid should be solved")
+	}
+
+	cell, _ := grid.GetCell(9, 9)
+	cell.SetValue(1)
+
+	if grid.IsSolved() {
+		t.Errorf("grid with duplicate digits should not be solved")
+	}
+}
+
+func TestSetString(t *testing.T) {
+	sets := NewGrid().GetSets()
+
+	if sets[0].String() != "row 1" || sets[10].String() != "column 2" || sets[26].String() != "box 9" {
+		t.Errorf("unexpected set strings: %s, %s, %s", sets[0], sets[10], sets[26])
+	}
+}
+
+func TestClone(t *testing.T) {
+	grid := NewGrid()
+	grid.LoadPrettyString(TEST_GRID_PRETTY_STRING)
+
+	clone := grid.Clone()
+
+	if !clone.Equals(grid) {
+		t.Errorf("the clone should be equal to the original grid")
+	}
+
+	cell, _ := clone.GetCell(9, 8)
+	cell.SetValue(4)
+
+	if clone.Equals(grid) {
+		t.Errorf("modifying the clone should not modify the original grid")
+	}
+
+	cells_in_box, _ := clone.GetCellsInBox(9)
+	if cells_in_box[7] != cell {
+		t.Errorf("the clone's boxes should reference the clone's cells")
+	}
+}